@@ -11,23 +11,17 @@ import (
 	"os/signal"
 	"runtime"
 	"strconv"
-	"sync"
 	"syscall"
 	"time"
 
 	"golang.org/x/sync/errgroup"
 
-	"github.com/google/go-cmp/cmp"
+	"github.com/quasilyte/phpsmith"
 	"github.com/quasilyte/phpsmith/cmd/phpsmith/interpretator/kphp"
 	"github.com/quasilyte/phpsmith/cmd/phpsmith/interpretator/php"
 )
 
-type Runner interface {
-	Run(ctx context.Context, filename string, seed int64) ([]byte, error)
-	Name() string
-}
-
-var runners = []Runner{
+var runners = []phpsmith.Runner{
 	php.Runner{},
 	kphp.Runner{},
 }
@@ -38,6 +32,14 @@ func cmdFuzz(args []string) error {
 		"Number of concurrent runners. Defaults to the half number of available CPU cores.")
 	flagOutputDir := fs.String("o", "phpsmith_out",
 		`output dir`)
+	flagPHP8 := fs.Bool("php8", false,
+		`whether to use the PHP 8 only syntax in the generated code`)
+	flagSingleFile := fs.Bool("single-file", false,
+		`whether to print every program into a single main.php file`)
+	flagSwarm := fs.Bool("swarm", false,
+		`whether to disable a random subset of the expression choices, statement kinds and builtins for every program`)
+	flagFeedback := fs.Bool("feedback", false,
+		`whether to adjust the expression choice weights using the features of the already generated programs`)
 
 	_ = fs.Parse(args)
 
@@ -64,10 +66,15 @@ func cmdFuzz(args []string) error {
 		cancel()
 	}()
 
+	var scheduler *phpsmith.FeedbackScheduler
+	if *flagFeedback {
+		scheduler = phpsmith.NewFeedbackScheduler()
+	}
+
 	dirCh := make(chan dirAndSeed, concurrency)
 	for i := 0; i < concurrency; i++ {
 		eg.Go(func() error {
-			return runner(ctx, dirCh)
+			return runner(ctx, dirCh, scheduler)
 		})
 	}
 
@@ -76,13 +83,21 @@ out:
 	for {
 		seed := randomizer.Int63()
 		newDir := dir + "_" + strconv.FormatInt(seed, 10)
-		if err := generate(newDir, seed); err != nil {
+		profile := phpsmith.Profile{PHP8: *flagPHP8, SingleFile: *flagSingleFile, Swarm: *flagSwarm}
+		if scheduler != nil {
+			profile.ExprWeights = scheduler.Weights()
+		}
+		program, err := generateProgram(seed, profile)
+		if err == nil {
+			err = program.WriteDir(newDir)
+		}
+		if err != nil {
 			log.Println("on generate: ", err)
 			continue
 		}
 
 		select {
-		case dirCh <- dirAndSeed{Dir: newDir, Seed: seed}:
+		case dirCh <- dirAndSeed{Dir: newDir, Seed: seed, Program: program}:
 		case <-ctx.Done():
 			break out
 		}
@@ -95,13 +110,16 @@ out:
 	return nil
 }
 
-func runner(ctx context.Context, dirCh <-chan dirAndSeed) error {
+func runner(ctx context.Context, dirCh <-chan dirAndSeed, scheduler *phpsmith.FeedbackScheduler) error {
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case ds := <-dirCh:
 			diffFound := fuzzingProcess(ctx, ds)
+			if scheduler != nil {
+				scheduler.Observe(ds.Program, diffFound)
+			}
 			suffix := ""
 			if diffFound {
 				suffix = "(found diff)"
@@ -115,83 +133,41 @@ func runner(ctx context.Context, dirCh <-chan dirAndSeed) error {
 	}
 }
 
-type executorOutput struct {
-	Output string
-	Error  string
-}
-
 type dirAndSeed struct {
-	Dir  string
-	Seed int64
+	Dir     string
+	Seed    int64
+	Program *phpsmith.GeneratedProgram
 }
 
 func fuzzingProcess(ctx context.Context, ds dirAndSeed) bool {
-	var (
-		results = make([]executorOutput, 0, len(runners))
-		wg      sync.WaitGroup
-		mu      sync.Mutex
-	)
-
-	wg.Add(len(runners))
-
-	for _, r := range runners {
-		innerCtx, cancel := context.WithTimeout(ctx, time.Minute)
-		//goland:noinspection GoDeferInLoop
-		defer cancel()
-
-		go func(r Runner) {
-			defer wg.Done()
-
-			out, err := r.Run(innerCtx, ds.Dir, ds.Seed)
-			grepExceptions(out, ds.Seed)
-
-			var msg string
-			if err != nil {
-				select {
-				case <-innerCtx.Done():
-					msg = fmt.Sprintf("too long execution for: %s on seed %d", r.Name(), ds.Seed)
-				default:
-					msg = err.Error()
-				}
-			}
-
-			mu.Lock()
-			defer mu.Unlock()
-
-			results = append(results, executorOutput{
-				Output: string(out),
-				Error:  msg,
-			})
-		}(r)
+	differential := &phpsmith.Differential{Runners: runners}
+	result := differential.Run(ctx, ds.Dir, ds.Seed)
+	for _, res := range result.Results {
+		grepExceptions([]byte(res.Output), ds.Seed)
 	}
-	wg.Wait()
-
-	writeLog := func(diff string) {
-		l, err := os.OpenFile("./"+ds.Dir+"/log", os.O_RDWR|os.O_CREATE, 0700)
-		if err != nil {
-			log.Println("-----------------------------")
-			log.Printf("diff: %s\t, seed: %d\t\n", diff, ds.Seed)
-			log.Printf("out: %s\terr: %s\t\n", results[0].Output, results[0].Error)
-			log.Printf("out: %s\terr: %s\t\n", results[1].Output, results[1].Error)
-			return
-		}
-		defer l.Close()
-
-		logger := log.New(l, "", 0)
-		logger.Printf("diff: %s\t, seed: %d\t\n", diff, ds.Seed)
-		logger.Printf("out: %s\terr: %s\t\n", results[0].Output, results[0].Error)
-		logger.Printf("out: %s\terr: %s\t\n", results[1].Output, results[1].Error)
+	if !result.Found() {
+		return false
 	}
 
-	diff := cmp.Diff(results[0].Output, results[1].Output)
-	if diff != "" {
-		writeLog(diff)
-	} else if results[0].Error != "" || results[1].Error != "" {
+	diff := result.Diff
+	if diff == "" {
 		diff = "nil"
-		writeLog(diff)
 	}
-
-	return diff != ""
+	writeLog := func(logger *log.Logger) {
+		logger.Printf("diff: %s\t, seed: %d\t\n", diff, ds.Seed)
+		for _, res := range result.Results {
+			logger.Printf("out: %s\terr: %s\t\n", res.Output, res.Error)
+		}
+	}
+	l, err := os.OpenFile("./"+ds.Dir+"/log", os.O_RDWR|os.O_CREATE, 0700)
+	if err != nil {
+		log.Println("-----------------------------")
+		writeLog(log.Default())
+		return true
+	}
+	defer l.Close()
+	writeLog(log.New(l, "", 0))
+	return true
 }
 
 func signalNotify(interrupt chan<- os.Signal) {
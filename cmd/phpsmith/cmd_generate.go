@@ -1,16 +1,20 @@
 package main
 
 import (
-	"bytes"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"log"
 	"math/rand"
 	"os"
-	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/quasilyte/phpsmith/irgen"
-	"github.com/quasilyte/phpsmith/irprint"
+	"github.com/quasilyte/phpsmith"
+	"github.com/quasilyte/phpsmith/randutil"
 )
 
 func cmdGenerate(args []string) error {
@@ -19,6 +23,36 @@ func cmdGenerate(args []string) error {
 		`a seed to be used during the code generation, 0 means "randomized seed"`)
 	flagOutputDir := fs.String("o", "phpsmith_out",
 		`output dir`)
+	flagPHP8 := fs.Bool("php8", false,
+		`whether to use the PHP 8 only syntax in the generated code`)
+	flagPHPOnly := fs.Bool("php-only", false,
+		`whether to use the constructs that KPHP doesn't support, like the local variable references`)
+	flagSourceMap := fs.Bool("sourcemap", false,
+		`whether to write a sourcemap.json that maps the generated code positions to the IR nodes`)
+	flagValidate := fs.Bool("validate", false,
+		`whether to check the generated IR consistency before printing it`)
+	flagRecordChoices := fs.Bool("record-choices", false,
+		`whether to write a choices.bin with the raw random source values drawn during the IR generation; `+
+			`these are not the decisions themselves, so the file depends on the draw order and math/rand internals `+
+			`and is only valid for the same phpsmith build`)
+	flagReplayChoices := fs.String("replay-choices", "",
+		`a choices.bin file to take the IR generation random values from, the seed is used only for printing; `+
+			`all sub-streams replay one shared sequence in the recorded order, so an edited or shrunk file `+
+			`shifts the later decisions rather than removing a specific one`)
+	flagSingleFile := fs.Bool("single-file", false,
+		`whether to print the entire program into a single main.php file`)
+	flagCount := fs.Int("count", 1,
+		`number of programs to generate, every program goes into its own <o>_<seed> dir if it's above 1`)
+	flagRandomSeeds := fs.Bool("random-seeds", false,
+		`whether to derive the -count seeds randomly from the -seed instead of incrementing it`)
+	flagSeedFile := fs.String("seed-file", "",
+		`a file with seeds to generate the programs for, one per line; "-" reads them from stdin`)
+	flagStdout := fs.Bool("stdout", false,
+		`whether to write a single-file program to stdout instead of the output dir`)
+	flagSwarm := fs.Bool("swarm", false,
+		`whether to disable a random subset of the expression choices, statement kinds and builtins`)
+	flagMetadata := fs.String("metadata", "",
+		`a metadata.json file to take the expression choice weights and the disabled features from, to reproduce a fuzz program`)
 	_ = fs.Parse(args)
 
 	seed := *flagSeed
@@ -26,45 +60,144 @@ func cmdGenerate(args []string) error {
 		seed = time.Now().Unix()
 	}
 
-	return generate(*flagOutputDir, seed)
-}
+	var seeds []int64
+	if *flagSeedFile != "" {
+		var err error
+		seeds, err = readSeedFile(*flagSeedFile)
+		if err != nil {
+			return err
+		}
+	} else {
+		seeds = makeSeeds(seed, *flagCount, *flagRandomSeeds)
+	}
+	if *flagStdout && len(seeds) != 1 {
+		return fmt.Errorf("-stdout needs exactly 1 seed, got %d", len(seeds))
+	}
 
-func generate(dir string, randomSeed int64) error {
-	random := rand.New(rand.NewSource(randomSeed))
+	profile := phpsmith.Profile{
+		PHP8:          *flagPHP8,
+		PHPOnly:       *flagPHPOnly,
+		SourceMap:     *flagSourceMap,
+		Validate:      *flagValidate,
+		RecordChoices: *flagRecordChoices,
+		SingleFile:    *flagSingleFile || *flagStdout,
+	}
+	if *flagReplayChoices != "" {
+		data, err := os.ReadFile(*flagReplayChoices)
+		if err != nil {
+			return err
+		}
+		profile.ReplayChoices, err = randutil.DecodeChoices(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", *flagReplayChoices, err)
+		}
+	}
 
-	if err := os.MkdirAll(dir, 0o700); err != nil && !os.IsExist(err) {
+	if *flagMetadata != "" {
+		data, err := os.ReadFile(*flagMetadata)
+		if err != nil {
+			return err
+		}
+		var metadata struct {
+			ExprWeights      map[string]float64 `json:"expr_weights"`
+			DisabledStmts    []string           `json:"disabled_stmts"`
+			DisabledBuiltins []string           `json:"disabled_builtins"`
+		}
+		if err := json.Unmarshal(data, &metadata); err != nil {
+			return fmt.Errorf("%s: %w", *flagMetadata, err)
+		}
+		profile.ExprWeights = metadata.ExprWeights
+		profile.DisabledStmts = metadata.DisabledStmts
+		profile.DisabledBuiltins = metadata.DisabledBuiltins
+	} else {
+		profile.Swarm = *flagSwarm
+	}
+
+	if *flagStdout {
+		program, err := generateProgram(seeds[0], profile)
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(program.Files[0].Contents)
 		return err
 	}
 
-	config := &irgen.Config{Rand: random}
-	program := irgen.CreateProgram(config)
-	printerConfig := &irprint.Config{
-		Rand: random,
+	if len(seeds) == 1 {
+		return generate(*flagOutputDir, seeds[0], profile)
 	}
+	for _, seed := range seeds {
+		dir := *flagOutputDir + "_" + strconv.FormatInt(seed, 10)
+		if err := generate(dir, seed, profile); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	for _, f := range program.RuntimeFiles {
-		fullname := filepath.Join(dir, f.Name)
-		if err := os.WriteFile(fullname, f.Contents, 0o664); err != nil {
-			return fmt.Errorf("create %s file: %w", fullname, err)
+// makeSeeds returns count seeds starting from the given one.
+// The random seeds are derived from it with a PRNG, so they're reproducible too.
+func makeSeeds(seed int64, count int, random bool) []int64 {
+	seeds := make([]int64, 0, count)
+	if random {
+		r := rand.New(rand.NewSource(seed))
+		for i := 0; i < count; i++ {
+			seeds = append(seeds, r.Int63())
 		}
+		return seeds
+	}
+	for i := 0; i < count; i++ {
+		seeds = append(seeds, seed+int64(i))
+	}
+	return seeds
+}
+
+// readSeedFile reads the seeds listed one per line.
+// Empty lines and the lines starting with # are ignored.
+func readSeedFile(filename string) ([]int64, error) {
+	var data []byte
+	var err error
+	if filename == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(filename)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	for _, f := range program.Files {
-		fullname := filepath.Join(dir, f.Name)
-		fileContents := makeFileContents(f, printerConfig)
-		if err := os.WriteFile(fullname, fileContents, 0o664); err != nil {
-			return fmt.Errorf("create %s file: %w", fullname, err)
+	var seeds []int64
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		seed, err := strconv.ParseInt(line, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: bad seed: %w", filename, i+1, err)
 		}
+		seeds = append(seeds, seed)
 	}
+	return seeds, nil
+}
 
-	return nil
+func generate(dir string, seed int64, profile phpsmith.Profile) error {
+	program, err := generateProgram(seed, profile)
+	if err != nil {
+		return err
+	}
+	return program.WriteDir(dir)
 }
 
-func makeFileContents(f *irgen.File, config *irprint.Config) []byte {
-	var buf bytes.Buffer
-	buf.WriteString("<?php\n")
-	for _, n := range f.Nodes {
-		irprint.FprintRootNode(&buf, n, config)
+func generateProgram(seed int64, profile phpsmith.Profile) (*phpsmith.GeneratedProgram, error) {
+	program, err := phpsmith.Generate(seed, profile)
+	if err != nil {
+		var invalid *phpsmith.InvalidProgramError
+		if errors.As(err, &invalid) {
+			for _, err := range invalid.Errors {
+				log.Printf("validate: %v", err)
+			}
+		}
+		return nil, err
 	}
-	return buf.Bytes()
+	return program, nil
 }
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/quasilyte/phpsmith"
+	"github.com/quasilyte/phpsmith/irstats"
+)
+
+func cmdStats(args []string) error {
+	fs := flag.NewFlagSet("phpsmith stats", flag.ExitOnError)
+	flagSeed := fs.Int64("seed", 0,
+		`the first seed to be used during the code generation, 0 means "randomized seed"`)
+	flagCount := fs.Int("count", 100,
+		`number of programs to generate`)
+	flagPHP8 := fs.Bool("php8", false,
+		`whether to use the PHP 8 only syntax in the generated code`)
+	flagJSON := fs.Bool("json", false,
+		`whether to print the stats as JSON`)
+	_ = fs.Parse(args)
+
+	seed := *flagSeed
+	if seed == 0 {
+		seed = time.Now().Unix()
+	}
+
+	stats := irstats.New()
+	profile := phpsmith.Profile{PHP8: *flagPHP8}
+	for _, seed := range makeSeeds(seed, *flagCount, false) {
+		program, err := generateProgram(seed, profile)
+		if err != nil {
+			return err
+		}
+		stats.Add(program.IR, printedFiles(program))
+	}
+
+	if *flagJSON {
+		data, err := json.MarshalIndent(stats, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(append(data, '\n'))
+		return err
+	}
+	printStats(os.Stdout, stats)
+	return nil
+}
+
+// printedFiles returns the program files printed from its IR,
+// skipping the runtime support files.
+func printedFiles(program *phpsmith.GeneratedProgram) []irstats.File {
+	generated := make(map[string]bool, len(program.IR))
+	for _, f := range program.IR {
+		generated[f.Name] = true
+	}
+	var files []irstats.File
+	for _, f := range program.Files {
+		if generated[f.Name] {
+			files = append(files, irstats.File{Name: f.Name, Contents: f.Contents})
+		}
+	}
+	return files
+}
+
+func printStats(w io.Writer, stats *irstats.Stats) {
+	fmt.Fprintf(w, "programs: %d\n", stats.Programs)
+	printHistogram(w, "ops", stats.Ops)
+	printHistogram(w, "types", stats.Types)
+	printHistogram(w, "builtins", stats.Builtins)
+	printHistogram(w, "statements", stats.Statements)
+	printDistribution(w, "expression depth", stats.ExprDepth)
+	printDistribution(w, "program size (bytes)", stats.ProgramSize)
+	printDistribution(w, "lines per file", stats.FileLines)
+}
+
+// printHistogram prints the most frequent features first.
+func printHistogram(w io.Writer, title string, h irstats.Histogram) {
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if h[names[i]] != h[names[j]] {
+			return h[names[i]] > h[names[j]]
+		}
+		return names[i] < names[j]
+	})
+
+	fmt.Fprintf(w, "\n%s:\n", title)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-24s %d\n", name, h[name])
+	}
+}
+
+func printDistribution(w io.Writer, title string, d *irstats.Distribution) {
+	fmt.Fprintf(w, "\n%s: min=%d max=%d mean=%.1f\n", title, d.Min, d.Max, d.Mean)
+	for _, b := range d.Buckets {
+		fmt.Fprintf(w, "  %-24s %d\n", fmt.Sprintf("%d-%d", b.Min, b.Max), b.Count)
+	}
+}
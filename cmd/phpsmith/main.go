@@ -36,6 +36,12 @@ func main() {
 			Description: "generate a program using the provided configuration",
 			Do:          generateMain,
 		},
+
+		{
+			Name:        "stats",
+			Description: "report the generated programs feature statistics",
+			Do:          statsMain,
+		},
 	}
 
 	subcmd.Run(cmds)
@@ -61,3 +67,9 @@ func generateMain(args []string) {
 		log.Fatalf("phpsmith generate: error: %v", err)
 	}
 }
+
+func statsMain(args []string) {
+	if err := cmdStats(args); err != nil {
+		log.Fatalf("phpsmith stats: error: %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package phpsmith
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// Runner executes a generated program.
+// The dir contains the program files written by GeneratedProgram.WriteDir.
+type Runner interface {
+	Run(ctx context.Context, dir string, seed int64) ([]byte, error)
+	Name() string
+}
+
+// Differential runs a program with several runners and compares their results.
+type Differential struct {
+	Runners []Runner
+
+	// Timeout limits every runner execution, a minute is used if it's zero.
+	Timeout time.Duration
+}
+
+// RunResult is a single runner execution result.
+type RunResult struct {
+	Runner string
+	Output string
+
+	// Error is empty if the program was executed successfully.
+	Error string
+}
+
+// DiffResult is a differential execution result.
+type DiffResult struct {
+	// Results follow the Differential.Runners order.
+	Results []RunResult
+
+	// Diff describes the output mismatch between the first
+	// and the other runners, it's empty if all outputs are identical.
+	Diff string
+}
+
+// Found reports whether the execution revealed a problem:
+// either the outputs differ or some runner failed.
+func (r *DiffResult) Found() bool {
+	if r.Diff != "" {
+		return true
+	}
+	for _, res := range r.Results {
+		if res.Error != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Run executes the program from dir with all runners concurrently.
+func (d *Differential) Run(ctx context.Context, dir string, seed int64) *DiffResult {
+	timeout := d.Timeout
+	if timeout == 0 {
+		timeout = time.Minute
+	}
+
+	results := make([]RunResult, len(d.Runners))
+	var wg sync.WaitGroup
+	wg.Add(len(d.Runners))
+	for i, r := range d.Runners {
+		go func(i int, r Runner) {
+			defer wg.Done()
+
+			runCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			out, err := r.Run(runCtx, dir, seed)
+			result := RunResult{Runner: r.Name(), Output: string(out)}
+			if err != nil {
+				select {
+				case <-runCtx.Done():
+					result.Error = fmt.Sprintf("too long execution for: %s on seed %d", r.Name(), seed)
+				default:
+					result.Error = err.Error()
+				}
+			}
+			results[i] = result
+		}(i, r)
+	}
+	wg.Wait()
+
+	result := &DiffResult{Results: results}
+	if len(results) == 0 {
+		return result
+	}
+	for _, res := range results[1:] {
+		if diff := cmp.Diff(results[0].Output, res.Output); diff != "" {
+			result.Diff += fmt.Sprintf("%s vs %s:\n%s", results[0].Runner, res.Runner, diff)
+		}
+	}
+	return result
+}
@@ -0,0 +1,147 @@
+package phpsmith
+
+import (
+	"math"
+	"sync"
+
+	"github.com/quasilyte/phpsmith/irstats"
+)
+
+const (
+	// feedbackCoverage is the number of feature occurrences per program
+	// after which the feature is considered to be covered.
+	feedbackCoverage = 5.0
+
+	// feedbackMaxBoost limits the expression choice weights.
+	feedbackMaxBoost = 4.0
+)
+
+// FeedbackScheduler adjusts the expression choice weights
+// using the features of the already generated programs.
+//
+// The features are described by irstats.Features. A feature is boosted
+// while it's rare, and also when it's more common among the programs
+// that revealed a problem than among all programs.
+// A choice weight depends on the features related to the ops it produced.
+//
+// It's safe for the concurrent use.
+type FeedbackScheduler struct {
+	mu sync.Mutex
+
+	programs      int
+	foundPrograms int
+
+	// counts are the feature occurrences over all programs.
+	counts map[string]int
+
+	// presence and foundPresence count the programs that have the feature.
+	presence      map[string]int
+	foundPresence map[string]int
+
+	// choiceOps count the ops produced by every expression choice.
+	choiceOps map[string]map[string]int
+}
+
+func NewFeedbackScheduler() *FeedbackScheduler {
+	return &FeedbackScheduler{
+		counts:        make(map[string]int),
+		presence:      make(map[string]int),
+		foundPresence: make(map[string]int),
+		choiceOps:     make(map[string]map[string]int),
+	}
+}
+
+// Observe records the program features.
+// The found tells whether the program execution revealed a problem,
+// see DiffResult.Found.
+func (s *FeedbackScheduler) Observe(p *GeneratedProgram, found bool) {
+	features := irstats.Features(p.IR)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.programs++
+	if found {
+		s.foundPrograms++
+	}
+	for f, n := range features {
+		s.counts[f] += n
+		s.presence[f]++
+		if found {
+			s.foundPresence[f]++
+		}
+	}
+	for name, ops := range p.ExprChoices {
+		counts := s.choiceOps[name]
+		if counts == nil {
+			counts = make(map[string]int)
+			s.choiceOps[name] = counts
+		}
+		for op, n := range ops {
+			counts[op.String()] += n
+		}
+	}
+}
+
+// Weights returns the expression choice weights for the next program.
+// It's nil until some programs are observed.
+func (s *FeedbackScheduler) Weights() map[string]float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.programs == 0 {
+		return nil
+	}
+
+	// An op score is a mean score of its related features.
+	type scoreSum struct {
+		total float64
+		n     int
+	}
+	opScores := make(map[string]*scoreSum)
+	for f := range s.counts {
+		score := s.featureScore(f)
+		for _, op := range irstats.FeatureOps(f) {
+			sum := opScores[op]
+			if sum == nil {
+				sum = &scoreSum{}
+				opScores[op] = sum
+			}
+			sum.total += score
+			sum.n++
+		}
+	}
+
+	weights := make(map[string]float64, len(s.choiceOps))
+	for name, ops := range s.choiceOps {
+		total := 0
+		for _, n := range ops {
+			total += n
+		}
+		w := 0.0
+		for op, n := range ops {
+			score := 1.0
+			if sum := opScores[op]; sum != nil {
+				score = sum.total / float64(sum.n)
+			}
+			w += score * float64(n) / float64(total)
+		}
+		weights[name] = math.Min(math.Max(w, 1), feedbackMaxBoost)
+	}
+	return weights
+}
+
+func (s *FeedbackScheduler) featureScore(f string) float64 {
+	score := 1.0
+	if perProgram := float64(s.counts[f]) / float64(s.programs); perProgram < feedbackCoverage {
+		score = math.Min(math.Sqrt(feedbackCoverage/perProgram), feedbackMaxBoost)
+	}
+	if s.foundPrograms != 0 {
+		foundRatio := float64(s.foundPresence[f]) / float64(s.foundPrograms)
+		ratio := float64(s.presence[f]) / float64(s.programs)
+		if lift := foundRatio / ratio; lift > 1 {
+			score *= math.Min(lift, feedbackMaxBoost)
+		}
+	}
+	return score
+}
@@ -1,5 +1,12 @@
 package ir
 
+// File is a named list of root nodes.
+type File struct {
+	Name string
+
+	Nodes []RootNode
+}
+
 type RootNode interface {
 	rootNode()
 }
@@ -8,6 +15,12 @@ type RootRequire struct {
 	Path string
 }
 
+// RootDeclare is a declare statement, like declare(strict_types=1).
+type RootDeclare struct {
+	Directive string
+	Value     *Node
+}
+
 type RootStmt struct {
 	X *Node
 }
@@ -25,6 +38,7 @@ type RootClassDecl struct {
 }
 
 func (n *RootRequire) rootNode()   {}
+func (n *RootDeclare) rootNode()   {}
 func (n *RootStmt) rootNode()      {}
 func (n *RootFuncDecl) rootNode()  {}
 func (n *RootClassDecl) rootNode() {}
@@ -95,6 +95,7 @@ const (
 	OpInterpolatedString
 
 	// $Args holds array elements
+	// Keyed elements are OpKeyValue
 	OpArrayLit
 
 	// $Value.(string) contains a variable name
@@ -239,9 +240,76 @@ const (
 
 	// $Args[0] ?? $Args[1]
 	OpNullCoalesce
+
+	// 'function' '(' $Value.(*FuncType).Params... ')' 'use' '(' $Args[1:]... ')' $Args[0]
+	// Use list elements are OpVar (by value) or OpRef (by reference)
+	// $Args[0] is OpBlock
+	OpClosure
+
+	// 'fn' '(' $Value.(*FuncType).Params... ')' '=>' $Args[0]
+	OpArrowFunc
+
+	// '&' $Args[0]
+	OpRef
+
+	// $Args[0] 'instanceof' $Value.(string)
+	OpInstanceof
+
+	// $Args[0] '::' $Value.(string)
+	// $Args[0] is OpName that holds a class name, 'self', 'static' or 'parent'
+	// Static field names include the '$' prefix
+	OpStaticMemberAccess
+
+	// 'try' $Args[0] $Args[1:]...
+	// $Args[0] is OpBlock
+	// Note: other args are OpCatch, optionally followed by OpFinally
+	OpTry
+
+	// 'catch' '(' $Value.([]string) $Args[0] ')' $Args[1]
+	// $Value holds the caught class names, multi-catch has several of them
+	// $Args[0] is OpVar, $Args[1] is OpBlock
+	OpCatch
+
+	// 'finally' $Args[0]
+	// $Args[0] is OpBlock
+	OpFinally
+
+	// 'throw' $Args[0]
+	OpThrow
+
+	// $Args[0] '=>' $Args[1]
+	OpKeyValue
+
+	// 'yield' $Args[0]
+	// $Args[0] can be OpKeyValue
+	OpYield
+
+	// 'yield' 'from' $Args[0]
+	OpYieldFrom
+
+	// 'foreach' '(' $Args[0] 'as' $Args[1] ')' $Args[2]
+	// $Args[1] is OpVar, OpRef (by-reference foreach) or OpList (destructuring),
+	// or OpKeyValue with OpVar key and one of them as a value
+	// $Args[2] is OpBlock
+	OpForeach
+
+	// 'unset' '(' $Args... ')'
+	OpUnset
+
+	// 'isset' '(' $Args... ')'
+	OpIsset
+
+	// $Args[0] '[' ']'
+	// Used as an assignment lhs to append a value to the array
+	OpEmptyIndex
+
+	// 'list' '(' $Args... ')' or '[' $Args... ']' if $Value.(bool) is true
+	// $Args are OpVar, OpList or OpKeyValue with OpVar or OpList value
+	// Skipped elements are nil
+	OpList
 )
 
-var statementOpsMap = [...]bool{
+var statementOpsMap = map[Op]bool{
 	OpBreak:      true,
 	OpContinue:   true,
 	OpIf:         true,
@@ -253,12 +321,19 @@ var statementOpsMap = [...]bool{
 	OpReturn:     true,
 	OpReturnVoid: true,
 	OpEcho:       true,
+	OpTry:        true,
+	OpThrow:      true,
+	OpForeach:    true,
+	OpUnset:      true,
 }
 
-var miscOpsMap = [...]bool{
+var miscOpsMap = map[Op]bool{
 	OpInvalid:     true,
 	OpCase:        true,
 	OpDefaultCase: true,
+	OpCatch:       true,
+	OpFinally:     true,
+	OpKeyValue:    true,
 }
 
 func NewBreak(value int) *Node {
@@ -495,3 +570,77 @@ func NewBitShiftRight(x, y *Node) *Node {
 func NewNullCoalesce(x, y *Node) *Node {
 	return &Node{Op: OpNullCoalesce, Args: []*Node{x, y}}
 }
+
+func NewClosure(typ *FuncType, body *Node, uses ...*Node) *Node {
+	allArgs := make([]*Node, len(uses)+1)
+	allArgs[0] = body
+	copy(allArgs[1:], uses)
+	return &Node{Op: OpClosure, Value: typ, Args: allArgs, Type: typ}
+}
+
+func NewArrowFunc(typ *FuncType, x *Node) *Node {
+	return &Node{Op: OpArrowFunc, Value: typ, Args: []*Node{x}, Type: typ}
+}
+
+func NewRef(x *Node) *Node {
+	return &Node{Op: OpRef, Args: []*Node{x}}
+}
+
+func NewStaticMemberAccess(class *Node, memberName string) *Node {
+	return &Node{Op: OpStaticMemberAccess, Value: memberName, Args: []*Node{class}}
+}
+
+func NewInstanceof(x *Node, className string) *Node {
+	return &Node{Op: OpInstanceof, Value: className, Args: []*Node{x}}
+}
+
+func NewTry(body *Node, clauses ...*Node) *Node {
+	allArgs := make([]*Node, len(clauses)+1)
+	allArgs[0] = body
+	copy(allArgs[1:], clauses)
+	return &Node{Op: OpTry, Args: allArgs}
+}
+
+func NewCatch(classNames []string, v, body *Node) *Node {
+	return &Node{Op: OpCatch, Value: classNames, Args: []*Node{v, body}}
+}
+
+func NewFinally(body *Node) *Node {
+	return &Node{Op: OpFinally, Args: []*Node{body}}
+}
+
+func NewThrow(x *Node) *Node {
+	return &Node{Op: OpThrow, Args: []*Node{x}}
+}
+
+func NewKeyValue(key, value *Node) *Node {
+	return &Node{Op: OpKeyValue, Args: []*Node{key, value}}
+}
+
+func NewYield(x *Node) *Node {
+	return &Node{Op: OpYield, Args: []*Node{x}}
+}
+
+func NewYieldFrom(x *Node) *Node {
+	return &Node{Op: OpYieldFrom, Args: []*Node{x}}
+}
+
+func NewForeach(x, as, body *Node) *Node {
+	return &Node{Op: OpForeach, Args: []*Node{x, as, body}}
+}
+
+func NewUnset(args ...*Node) *Node {
+	return &Node{Op: OpUnset, Args: args}
+}
+
+func NewIsset(args ...*Node) *Node {
+	return &Node{Op: OpIsset, Args: args}
+}
+
+func NewEmptyIndex(array *Node) *Node {
+	return &Node{Op: OpEmptyIndex, Args: []*Node{array}}
+}
+
+func NewList(short bool, args ...*Node) *Node {
+	return &Node{Op: OpList, Value: short, Args: args}
+}
@@ -79,11 +79,28 @@ func _() {
 	_ = x[OpBitShiftLeft-68]
 	_ = x[OpBitShiftRight-69]
 	_ = x[OpNullCoalesce-70]
+	_ = x[OpClosure-71]
+	_ = x[OpArrowFunc-72]
+	_ = x[OpRef-73]
+	_ = x[OpInstanceof-74]
+	_ = x[OpStaticMemberAccess-75]
+	_ = x[OpTry-76]
+	_ = x[OpCatch-77]
+	_ = x[OpFinally-78]
+	_ = x[OpThrow-79]
+	_ = x[OpKeyValue-80]
+	_ = x[OpYield-81]
+	_ = x[OpYieldFrom-82]
+	_ = x[OpForeach-83]
+	_ = x[OpUnset-84]
+	_ = x[OpIsset-85]
+	_ = x[OpEmptyIndex-86]
+	_ = x[OpList-87]
 }
 
-const _Op_name = "InvalidBadBreakContinueIfIfElseSwitchCaseDefaultCaseWhileDoWhileBlockReturnReturnVoidEchoParensAssignAssignModifyBoolLitIntLitFloatLitStringLitInterpolatedStringArrayLitVarNameNewNotMemberAccessIndexNegationUnaryPlusConcatAddSubDivMulModExpAndAndWordOrOrWordXorWordTernaryCallLessLessOrEqualGreaterGreaterOrEqualEqual2FloatEqual2Equal3FloatEqual3NotEqual2NotFloatEqual2NotEqual3NotFloatEqual3SpaceshipPostIncPreIncPostDecPreDecCastBitAndBitOrBitXorBitNotBitShiftLeftBitShiftRightNullCoalesce"
+const _Op_name = "InvalidBadBreakContinueIfIfElseSwitchCaseDefaultCaseWhileDoWhileBlockReturnReturnVoidEchoParensAssignAssignModifyBoolLitIntLitFloatLitStringLitInterpolatedStringArrayLitVarNameNewNotMemberAccessIndexNegationUnaryPlusConcatAddSubDivMulModExpAndAndWordOrOrWordXorWordTernaryCallLessLessOrEqualGreaterGreaterOrEqualEqual2FloatEqual2Equal3FloatEqual3NotEqual2NotFloatEqual2NotEqual3NotFloatEqual3SpaceshipPostIncPreIncPostDecPreDecCastBitAndBitOrBitXorBitNotBitShiftLeftBitShiftRightNullCoalesceClosureArrowFuncRefInstanceofStaticMemberAccessTryCatchFinallyThrowKeyValueYieldYieldFromForeachUnsetIssetEmptyIndexList"
 
-var _Op_index = [...]uint16{0, 7, 10, 15, 23, 25, 31, 37, 41, 52, 57, 64, 69, 75, 85, 89, 95, 101, 113, 120, 126, 134, 143, 161, 169, 172, 176, 179, 182, 194, 199, 207, 216, 222, 225, 228, 231, 234, 237, 240, 243, 250, 252, 258, 265, 272, 276, 280, 291, 298, 312, 318, 329, 335, 346, 355, 369, 378, 392, 401, 408, 414, 421, 427, 431, 437, 442, 448, 454, 466, 479, 491}
+var _Op_index = [...]uint16{0, 7, 10, 15, 23, 25, 31, 37, 41, 52, 57, 64, 69, 75, 85, 89, 95, 101, 113, 120, 126, 134, 143, 161, 169, 172, 176, 179, 182, 194, 199, 207, 216, 222, 225, 228, 231, 234, 237, 240, 243, 250, 252, 258, 265, 272, 276, 280, 291, 298, 312, 318, 329, 335, 346, 355, 369, 378, 392, 401, 408, 414, 421, 427, 431, 437, 442, 448, 454, 466, 479, 491, 498, 507, 510, 520, 538, 541, 546, 553, 558, 566, 571, 580, 587, 592, 597, 607, 611}
 
 func (i Op) String() string {
 	if i < 0 || i >= Op(len(_Op_index)-1) {
@@ -8,6 +8,13 @@ const (
 	FlagPublic
 	FlagIntGtZero
 	FlagStringNonEmpty
+	FlagStatic
+	FlagAbstract
+	FlagFinal
+	FlagInterface
+	FlagPromoted
+	FlagRef
+	FlagTypeHinted
 )
 
 func (flags TypeFlags) IsPrivate() bool   { return flags&FlagPrivate != 0 }
@@ -16,3 +23,18 @@ func (flags TypeFlags) IsPublic() bool    { return flags&FlagPublic != 0 }
 
 func (flags TypeFlags) IsGtZeroInt() bool      { return flags&FlagIntGtZero != 0 }
 func (flags TypeFlags) IsNonEmptyString() bool { return flags&FlagStringNonEmpty != 0 }
+
+func (flags TypeFlags) IsStatic() bool    { return flags&FlagStatic != 0 }
+func (flags TypeFlags) IsAbstract() bool  { return flags&FlagAbstract != 0 }
+func (flags TypeFlags) IsFinal() bool     { return flags&FlagFinal != 0 }
+func (flags TypeFlags) IsInterface() bool { return flags&FlagInterface != 0 }
+
+// IsPromoted reports whether a field is declared by a constructor param.
+func (flags TypeFlags) IsPromoted() bool { return flags&FlagPromoted != 0 }
+
+// IsRef reports whether a param is passed by reference.
+func (flags TypeFlags) IsRef() bool { return flags&FlagRef != 0 }
+
+// IsTypeHinted reports whether a param, a field or a func result
+// is printed with a native type declaration.
+func (flags TypeFlags) IsTypeHinted() bool { return flags&FlagTypeHinted != 0 }
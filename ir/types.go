@@ -20,6 +20,7 @@ const (
 	TypeTagTuple
 	TypeTagFunc
 	TypeTagEnum
+	TypeTagGenerator
 )
 
 var (
@@ -77,9 +78,25 @@ type ScalarType struct {
 type ClassType struct {
 	Name string
 
+	// Flags can be used to mark class as abstract, final or interface.
+	Flags TypeFlags
+
+	Parent *ClassType
+
+	Interfaces []*ClassType
+
+	// Fields include both instance and static fields.
+	// Static fields have FlagStatic set.
 	Fields []TypeField
 
+	// Consts are class constants, their Init is always set.
+	Consts []TypeField
+
 	Methods []*FuncType
+
+	// Constructor is a __construct method declared by this class.
+	// It's nil if the class has no constructor or inherits the parent one.
+	Constructor *FuncType
 }
 
 type UnionType struct {
@@ -92,6 +109,9 @@ type NullableType struct {
 }
 
 type ArrayType struct {
+	// Key is nil for the lists, their keys are sequential ints.
+	// Otherwise it's int, string or mixed (int or string) type.
+	Key  Type
 	Elem Type
 }
 
@@ -101,6 +121,7 @@ type TupleType struct {
 
 type FuncType struct {
 	Name       string
+	Flags      TypeFlags
 	Params     []TypeField
 	Tags       []phpdoc.Tag
 	MinArgsNum int
@@ -117,19 +138,79 @@ func (typ *FuncType) FullName() string {
 	return typ.Class.Name + "::" + typ.Name
 }
 
+// IsSubclassOf reports whether typ extends or implements other.
+// A type is not a subclass of itself.
+func (typ *ClassType) IsSubclassOf(other *ClassType) bool {
+	for _, iface := range typ.Interfaces {
+		if iface == other || iface.IsSubclassOf(other) {
+			return true
+		}
+	}
+	if typ.Parent == nil {
+		return false
+	}
+	return typ.Parent == other || typ.Parent.IsSubclassOf(other)
+}
+
+// FindMethod looks up a method by its name, starting from typ and going up through its parents.
+// It returns nil if there is no such method.
+func (typ *ClassType) FindMethod(name string) *FuncType {
+	for c := typ; c != nil; c = c.Parent {
+		for _, m := range c.Methods {
+			if m.Name == name {
+				return m
+			}
+		}
+	}
+	return nil
+}
+
+// FindConstructor returns the constructor that is used to create the typ instances.
+// It returns nil if neither typ nor its parents declare a constructor.
+func (typ *ClassType) FindConstructor() *FuncType {
+	for c := typ; c != nil; c = c.Parent {
+		if c.Constructor != nil {
+			return c.Constructor
+		}
+	}
+	return nil
+}
+
+// KeyType returns the type of the typ array keys.
+func (typ *ArrayType) KeyType() Type {
+	if typ.Key == nil {
+		return IntType
+	}
+	return typ.Key
+}
+
+// GeneratorType is a result type of the functions that use yield.
+type GeneratorType struct {
+	// Key and Value are the types of the yielded keys and values.
+	Key   Type
+	Value Type
+
+	// Send is a type of the values passed to the Generator::send().
+	Send Type
+
+	// Return is a type of the Generator::getReturn() result.
+	Return Type
+}
+
 type EnumType struct {
 	ValueType *ScalarType
 	Values    []interface{}
 }
 
-func (typ *ScalarType) Tag() int   { return TypeTagScalar }
-func (typ *ClassType) Tag() int    { return TypeTagClass }
-func (typ *UnionType) Tag() int    { return TypeTagUnion }
-func (typ *NullableType) Tag() int { return TypeTagNullable }
-func (typ *ArrayType) Tag() int    { return TypeTagArray }
-func (typ *EnumType) Tag() int     { return TypeTagEnum }
-func (typ *TupleType) Tag() int    { return TypeTagTuple }
-func (typ *FuncType) Tag() int     { return TypeTagFunc }
+func (typ *ScalarType) Tag() int    { return TypeTagScalar }
+func (typ *ClassType) Tag() int     { return TypeTagClass }
+func (typ *UnionType) Tag() int     { return TypeTagUnion }
+func (typ *NullableType) Tag() int  { return TypeTagNullable }
+func (typ *ArrayType) Tag() int     { return TypeTagArray }
+func (typ *EnumType) Tag() int      { return TypeTagEnum }
+func (typ *TupleType) Tag() int     { return TypeTagTuple }
+func (typ *FuncType) Tag() int      { return TypeTagFunc }
+func (typ *GeneratorType) Tag() int { return TypeTagGenerator }
 
 func (typ *ScalarType) String() string {
 	return typ.Kind.String()
@@ -148,7 +229,10 @@ func (typ *NullableType) String() string {
 }
 
 func (typ *ArrayType) String() string {
-	return "(" + typ.Elem.String() + "[])"
+	if key, ok := typ.Key.(*ScalarType); typ.Key == nil || ok && key.Kind == ScalarInt {
+		return "(" + typ.Elem.String() + "[])"
+	}
+	return "array<" + typ.Key.String() + "," + typ.Elem.String() + ">"
 }
 
 func (typ *EnumType) String() string {
@@ -163,6 +247,11 @@ func (typ *TupleType) String() string {
 	return "tuple(" + strings.Join(parts, ",") + ")"
 }
 
+func (typ *GeneratorType) String() string {
+	parts := []string{typ.Key.String(), typ.Value.String(), typ.Send.String(), typ.Return.String()}
+	return "Generator<" + strings.Join(parts, ",") + ">"
+}
+
 func (typ *FuncType) String() string {
 	args := make([]string, len(typ.Params))
 	for i, e := range typ.Params {
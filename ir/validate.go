@@ -0,0 +1,730 @@
+package ir
+
+import (
+	"fmt"
+
+	"github.com/quasilyte/phpsmith/phpdoc"
+)
+
+// ValidationError describes an IR consistency violation.
+type ValidationError struct {
+	File string
+
+	// Where describes the enclosing declaration,
+	// like "func f", "method Foo::f" or "field Foo::$x".
+	Where string
+
+	// Path is a list of Args indexes from the declaration top node.
+	Path []int
+
+	Node *Node
+
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	op := "<decl>"
+	if e.Node != nil {
+		op = e.Node.Op.String()
+	}
+	return fmt.Sprintf("%s: %s: %v %s: %s", e.File, e.Where, e.Path, op, e.Message)
+}
+
+// Validate checks the program IR consistency:
+//
+//   - the ops arity and their Value dynamic types match the Op docs
+//   - variables are defined before they're used
+//   - variables are used with the types they were defined with
+//   - break and continue appear only inside the loops and switches
+//   - the user function calls have the number of args their FuncType permits
+//   - the assigned values, call args, returned values and initializers
+//     agree with the declared types, when the value type is known;
+//     the scalar literal args of the type-hinted scalar params can be coerced
+//   - the phpdoc tags agree with the declared types
+//   - the concrete classes implement all inherited abstract
+//     and interface methods
+//
+// All program files should be passed at once, so the calls
+// across the files can be resolved.
+func Validate(files []*File) []*ValidationError {
+	v := &validator{
+		funcs:   make(map[string]*FuncType),
+		classes: make(map[string]*ClassType),
+		globals: newValidationScope(),
+	}
+	for _, f := range files {
+		for _, n := range f.Nodes {
+			switch n := n.(type) {
+			case *RootFuncDecl:
+				v.funcs[n.Type.Name] = n.Type
+			case *RootClassDecl:
+				v.classes[n.Type.Name] = n.Type
+			}
+		}
+	}
+
+	for _, f := range files {
+		v.file = f.Name
+		for _, n := range f.Nodes {
+			v.validateRoot(n)
+		}
+	}
+	return v.errors
+}
+
+type validator struct {
+	errors []*ValidationError
+
+	funcs   map[string]*FuncType
+	classes map[string]*ClassType
+
+	file  string
+	where string
+	path  []int
+
+	class *ClassType
+
+	// fn is the enclosing function or closure,
+	// it's nil outside of the functions.
+	fn *FuncType
+
+	scope   *validationScope
+	globals *validationScope
+
+	// loopDepth is a number of the enclosing loops and switches
+	// inside the current function.
+	loopDepth int
+}
+
+// validationScope is a function-level variable scope.
+// The control flow is not taken into account: a variable is
+// considered defined after any preceding assignment.
+type validationScope struct {
+	vars map[string][]Type
+}
+
+func newValidationScope() *validationScope {
+	return &validationScope{vars: make(map[string][]Type)}
+}
+
+func (s *validationScope) define(name string, typ Type) {
+	types, ok := s.vars[name]
+	if !ok {
+		s.vars[name] = nil
+	}
+	if typ == nil {
+		return
+	}
+	for _, t := range types {
+		if t.String() == typ.String() {
+			return
+		}
+	}
+	s.vars[name] = append(types, typ)
+}
+
+func (s *validationScope) clone() *validationScope {
+	cloned := newValidationScope()
+	for name, types := range s.vars {
+		cloned.vars[name] = append([]Type(nil), types...)
+	}
+	return cloned
+}
+
+func (v *validator) errorf(n *Node, format string, args ...interface{}) {
+	v.errors = append(v.errors, &ValidationError{
+		File:    v.file,
+		Where:   v.where,
+		Path:    append([]int{}, v.path...),
+		Node:    n,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validator) validateRoot(n RootNode) {
+	v.class = nil
+	v.fn = nil
+	switch n := n.(type) {
+	case *RootFuncDecl:
+		v.where = "func " + n.Type.Name
+		v.validateFunc(n)
+	case *RootStmt:
+		v.where = "global code"
+		v.enterTop(v.globals)
+		v.validateNode(n.X)
+	case *RootDeclare:
+		v.where = "declare " + n.Directive
+		v.enterTop(newValidationScope())
+		v.validateNode(n.Value)
+	case *RootClassDecl:
+		v.class = n.Type
+		v.where = "class " + n.Type.Name
+		v.validateAbstractMethods(n.Type)
+		for _, c := range n.Type.Consts {
+			v.where = "const " + n.Type.Name + "::" + c.Name
+			v.validateInit(c.Init, c.Type)
+		}
+		for _, field := range n.Type.Fields {
+			v.where = "field " + n.Type.Name + "::$" + field.Name
+			v.validateInit(field.Init, field.Type)
+		}
+		for _, m := range n.Methods {
+			v.where = "method " + m.Type.FullName()
+			v.validateFunc(m)
+		}
+	}
+}
+
+// validateAbstractMethods checks that a concrete class c
+// implements all the abstract methods it inherits.
+func (v *validator) validateAbstractMethods(c *ClassType) {
+	if c.Flags.IsAbstract() || c.Flags.IsInterface() {
+		return
+	}
+	v.enterTop(newValidationScope())
+	for p := c; p != nil; p = p.Parent {
+		for _, m := range p.Methods {
+			if m.Flags.IsAbstract() && c.FindMethod(m.Name) == m {
+				v.errorf(nil, "%s is not implemented", m.FullName())
+			}
+		}
+		for _, iface := range p.Interfaces {
+			for _, m := range iface.Methods {
+				if impl := c.FindMethod(m.Name); impl == nil || impl.Flags.IsAbstract() {
+					v.errorf(nil, "%s is not implemented", m.FullName())
+				}
+			}
+		}
+	}
+}
+
+func (v *validator) enterTop(scope *validationScope) {
+	v.scope = scope
+	v.path = v.path[:0]
+	v.loopDepth = 0
+}
+
+func (v *validator) validateInit(init interface{}, typ Type) {
+	if init == nil {
+		return
+	}
+	n, ok := init.(*Node)
+	if !ok {
+		v.enterTop(newValidationScope())
+		v.errorf(nil, "initializer is %T, not *Node", init)
+		return
+	}
+	v.enterTop(newValidationScope())
+	v.validateNode(n)
+	if valueType := exprType(n); typ != nil && valueType != nil && !assignable(typ, valueType) {
+		v.errorf(n, "initializer is %s, but the type is %s", valueType, typ)
+	}
+}
+
+func (v *validator) validateFunc(decl *RootFuncDecl) {
+	v.enterTop(newValidationScope())
+	v.fn = decl.Type
+	v.validateTags(decl.Type)
+	if decl.Body == nil {
+		return
+	}
+	v.defineParams(decl.Type)
+	if v.class != nil && !decl.Type.Flags.IsStatic() {
+		v.scope.define("this", v.class)
+	}
+	v.validateNode(decl.Body)
+}
+
+func (v *validator) defineParams(fn *FuncType) {
+	for _, p := range fn.Params {
+		v.scope.define(p.Name, p.Type)
+	}
+}
+
+func (v *validator) validateTags(fn *FuncType) {
+	for _, tag := range fn.Tags {
+		switch tag := tag.(type) {
+		case *phpdoc.ReturnTag:
+			if fn.Result != nil && tag.Type != fn.Result.String() {
+				v.errorf(nil, "@return %s doesn't match %s result type", tag.Type, fn.Result)
+			}
+		case *phpdoc.ParamTag:
+			found := false
+			for _, p := range fn.Params {
+				if tag.VarName != "$"+p.Name {
+					continue
+				}
+				found = true
+				if tag.Type != p.Type.String() {
+					v.errorf(nil, "@param %s doesn't match $%s type %s", tag.Value(), p.Name, p.Type)
+				}
+			}
+			if !found {
+				v.errorf(nil, "@param %s refers to unknown param", tag.Value())
+			}
+		}
+	}
+}
+
+func (v *validator) validateNode(n *Node) {
+	if !v.validateShape(n) {
+		return
+	}
+
+	switch n.Op {
+	case OpVar:
+		v.useVar(n)
+		return
+
+	case OpBreak, OpContinue:
+		level := n.Value.(int)
+		if level == 0 {
+			level = 1
+		}
+		if level > v.loopDepth {
+			v.errorf(n, "%s %d with %d enclosing loops", n.Op, n.Value, v.loopDepth)
+		}
+		return
+
+	case OpWhile, OpDoWhile, OpForeach, OpSwitch:
+		v.loopDepth++
+		defer func() { v.loopDepth-- }()
+
+	case OpCall:
+		v.validateCall(n)
+
+	case OpNew:
+		if class, ok := n.Type.(*ClassType); ok {
+			if ctor := class.FindConstructor(); ctor != nil {
+				v.validateArgsNum(n, ctor, len(n.Args))
+				v.validateArgTypes(n, ctor, 0)
+			}
+		}
+
+	case OpReturn:
+		v.validateResult(n, n.Args[0])
+
+	case OpClosure, OpArrowFunc:
+		v.validateFuncLit(n)
+		return
+	}
+
+	switch n.Op {
+	case OpAssign:
+		// The rhs is evaluated before the variables are defined.
+		v.validateArg(n, 1)
+		v.validateAssignTarget(n, 0)
+		if varTag, ok := n.Value.(*phpdoc.VarTag); ok && n.Args[0].Op == OpVar {
+			lhs := n.Args[0]
+			if varTag.VarName != "" && varTag.VarName != "$"+lhs.Value.(string) {
+				v.errorf(n, "@var %s is attached to $%s assignment", varTag.Value(), lhs.Value)
+			}
+			if lhs.Type != nil && varTag.Type != lhs.Type.String() {
+				v.errorf(n, "@var %s doesn't match $%s type %s", varTag.Value(), lhs.Value, lhs.Type)
+			}
+		}
+		lhsType, rhsType := exprType(n.Args[0]), exprType(n.Args[1])
+		if lhsType != nil && rhsType != nil && !assignable(lhsType, rhsType) {
+			v.errorf(n, "%s is assigned to %s", rhsType, lhsType)
+		}
+	case OpForeach:
+		v.validateArg(n, 0)
+		v.validateAssignTarget(n, 1)
+		v.validateArg(n, 2)
+	case OpCatch:
+		v.validateAssignTarget(n, 0)
+		v.validateArg(n, 1)
+	default:
+		for i := range n.Args {
+			v.validateArg(n, i)
+		}
+	}
+}
+
+func (v *validator) validateArg(n *Node, i int) {
+	if n.Args[i] == nil {
+		return
+	}
+	v.path = append(v.path, i)
+	v.validateNode(n.Args[i])
+	v.path = v.path[:len(v.path)-1]
+}
+
+// validateAssignTarget defines the variables that are assigned by n.Args[i].
+func (v *validator) validateAssignTarget(n *Node, i int) {
+	target := n.Args[i]
+	if target == nil {
+		return
+	}
+	v.path = append(v.path, i)
+	defer func() { v.path = v.path[:len(v.path)-1] }()
+
+	switch target.Op {
+	case OpVar:
+		if v.validateShape(target) {
+			v.scope.define(target.Value.(string), target.Type)
+		}
+	case OpRef:
+		v.validateAssignTarget(target, 0)
+	case OpKeyValue, OpList:
+		if !v.validateShape(target) {
+			return
+		}
+		for j := range target.Args {
+			v.validateAssignTarget(target, j)
+		}
+	default:
+		v.validateNode(target)
+	}
+}
+
+func (v *validator) useVar(n *Node) {
+	name := n.Value.(string)
+	types, ok := v.scope.vars[name]
+	if !ok {
+		v.errorf(n, "$%s is used before it's defined", name)
+		return
+	}
+	if n.Type == nil || len(types) == 0 {
+		return
+	}
+	for _, t := range types {
+		if t.String() == n.Type.String() {
+			return
+		}
+	}
+	v.errorf(n, "$%s is used as %s, but defined as %v", name, n.Type, types)
+}
+
+func (v *validator) validateFuncLit(n *Node) {
+	fn := n.Value.(*FuncType)
+
+	outerScope := v.scope
+	outerLoopDepth := v.loopDepth
+	outerFn := v.fn
+	defer func() {
+		v.scope = outerScope
+		v.loopDepth = outerLoopDepth
+		v.fn = outerFn
+	}()
+	v.fn = fn
+
+	if n.Op == OpArrowFunc {
+		// Arrow functions capture the entire parent scope by value.
+		v.scope = outerScope.clone()
+		v.defineParams(fn)
+		v.loopDepth = 0
+		v.validateArg(n, 0)
+		v.validateResult(n, n.Args[0])
+		return
+	}
+
+	closureScope := newValidationScope()
+	if this, ok := outerScope.vars["this"]; ok {
+		closureScope.vars["this"] = this
+	}
+	for i, use := range n.Args[1:] {
+		v.path = append(v.path, i+1)
+		switch {
+		case use.Op == OpVar:
+			v.useVar(use)
+		case use.Op == OpRef && len(use.Args) == 1 && use.Args[0].Op == OpVar:
+			// A by-reference use binding defines the variable in the parent scope.
+			use = use.Args[0]
+			outerScope.define(use.Value.(string), use.Type)
+		default:
+			v.errorf(use, "closure use list element is %s", use.Op)
+			use = nil
+		}
+		v.path = v.path[:len(v.path)-1]
+		if use != nil {
+			name := use.Value.(string)
+			closureScope.vars[name] = outerScope.vars[name]
+		}
+	}
+	v.scope = closureScope
+	v.defineParams(fn)
+	v.loopDepth = 0
+	v.validateArg(n, 0)
+}
+
+func (v *validator) validateCall(n *Node) {
+	fn := v.resolveCallee(n.Args[0])
+	if fn != nil {
+		v.validateArgsNum(n, fn, len(n.Args)-1)
+		v.validateArgTypes(n, fn, 1)
+	}
+}
+
+// validateArgTypes checks the fn args that start from n.Args[first].
+func (v *validator) validateArgTypes(n *Node, fn *FuncType, first int) {
+	for i, param := range fn.Params {
+		if first+i >= len(n.Args) {
+			break
+		}
+		arg := n.Args[first+i]
+		if argType := exprType(arg); param.Type != nil && argType != nil && !assignable(param.Type, argType) && !coercibleArg(param, arg) {
+			v.path = append(v.path, first+i)
+			v.errorf(arg, "%s $%s param is %s, but the arg is %s", funcName(fn), param.Name, param.Type, argType)
+			v.path = v.path[:len(v.path)-1]
+		}
+	}
+}
+
+// coercibleArg reports whether arg is converted to the param type
+// in the non-strict mode. The strict mode throws a TypeError before the call,
+// so the param has its declared type in both cases.
+func coercibleArg(param TypeField, arg *Node) bool {
+	if _, ok := param.Type.(*ScalarType); !ok || !param.Flags.IsTypeHinted() {
+		return false
+	}
+	switch arg.Op {
+	case OpBoolLit, OpIntLit, OpFloatLit, OpStringLit:
+		return true
+	default:
+		return false
+	}
+}
+
+// validateResult checks the x value returned from the enclosing function.
+func (v *validator) validateResult(n, x *Node) {
+	if v.fn == nil || v.fn.Result == nil {
+		return
+	}
+	result := v.fn.Result
+	if gen, ok := result.(*GeneratorType); ok {
+		result = gen.Return
+	}
+	if result == VoidType {
+		if n.Op == OpReturn {
+			v.errorf(n, "value is returned from %s with void result", funcName(v.fn))
+		}
+		return
+	}
+	if typ := exprType(x); typ != nil && !assignable(result, typ) {
+		v.errorf(n, "%s is returned from %s with %s result", typ, funcName(v.fn), result)
+	}
+}
+
+func (v *validator) validateArgsNum(n *Node, fn *FuncType, numArgs int) {
+	if numArgs < fn.MinArgsNum {
+		v.errorf(n, "%s called with %d args, at least %d are required", fn.FullName(), numArgs, fn.MinArgsNum)
+	}
+	if numArgs > len(fn.Params) {
+		v.errorf(n, "%s called with %d args, it has only %d params", fn.FullName(), numArgs, len(fn.Params))
+	}
+}
+
+// resolveCallee returns the called function type.
+// It returns nil for the builtin and unresolved functions.
+func (v *validator) resolveCallee(callee *Node) *FuncType {
+	switch callee.Op {
+	case OpName:
+		return v.funcs[callee.Value.(string)]
+	case OpVar:
+		fn, _ := callee.Type.(*FuncType)
+		return fn
+	case OpMemberAccess:
+		if len(callee.Args) != 1 {
+			return nil
+		}
+		class, ok := callee.Args[0].Type.(*ClassType)
+		if !ok {
+			return nil
+		}
+		return class.FindMethod(callee.Value.(string))
+	case OpStaticMemberAccess:
+		if len(callee.Args) != 1 || callee.Args[0].Op != OpName {
+			return nil
+		}
+		var class *ClassType
+		switch name := callee.Args[0].Value.(string); name {
+		case "self", "static":
+			class = v.class
+		case "parent":
+			if v.class != nil {
+				class = v.class.Parent
+			}
+		default:
+			class = v.classes[name]
+		}
+		if class == nil {
+			return nil
+		}
+		return class.FindMethod(callee.Value.(string))
+	}
+	return nil
+}
+
+// validateShape checks the n arity and Value type.
+// It returns false if n can't be validated any further.
+func (v *validator) validateShape(n *Node) bool {
+	if int(n.Op) <= int(OpInvalid) || int(n.Op) > int(OpList) {
+		v.errorf(n, "invalid op")
+		return false
+	}
+
+	minArgs, maxArgs := opArity(n.Op)
+	if len(n.Args) < minArgs || (maxArgs != -1 && len(n.Args) > maxArgs) {
+		v.errorf(n, "has %d args", len(n.Args))
+		return false
+	}
+	for i, arg := range n.Args {
+		if arg == nil && n.Op != OpList {
+			v.errorf(n, "Args[%d] is nil", i)
+			return false
+		}
+	}
+
+	if !validValue(n) {
+		v.errorf(n, "unexpected %T value", n.Value)
+		return false
+	}
+	return true
+}
+
+// opArity returns the min and max number of args for op.
+// A max of -1 means there is no upper limit.
+func opArity(op Op) (minArgs, maxArgs int) {
+	switch op {
+	case OpBad, OpBreak, OpContinue, OpReturnVoid,
+		OpBoolLit, OpIntLit, OpFloatLit, OpStringLit, OpVar, OpName:
+		return 0, 0
+	case OpBlock, OpInterpolatedString, OpArrayLit, OpNew, OpDefaultCase:
+		return 0, -1
+	case OpSwitch, OpCase, OpEcho, OpCall, OpClosure, OpTry, OpUnset, OpIsset, OpList:
+		return 1, -1
+	case OpReturn, OpParens, OpNot, OpMemberAccess, OpNegation, OpUnaryPlus,
+		OpPostInc, OpPreInc, OpPostDec, OpPreDec, OpCast, OpBitNot, OpArrowFunc,
+		OpRef, OpInstanceof, OpStaticMemberAccess, OpFinally, OpThrow,
+		OpYield, OpYieldFrom, OpEmptyIndex:
+		return 1, 1
+	case OpIfElse, OpTernary, OpForeach:
+		return 3, 3
+	default:
+		return 2, 2
+	}
+}
+
+// validValue reports whether n.Value has the type documented for the n.Op.
+func validValue(n *Node) bool {
+	switch n.Op {
+	case OpBad, OpVar, OpName, OpNew, OpMemberAccess, OpInstanceof, OpStaticMemberAccess, OpStringLit:
+		_, ok := n.Value.(string)
+		return ok
+	case OpBreak, OpContinue:
+		_, ok := n.Value.(int)
+		return ok
+	case OpAssign:
+		if n.Value == nil {
+			return true
+		}
+		_, ok := n.Value.(*phpdoc.VarTag)
+		return ok
+	case OpAssignModify:
+		_, ok := n.Value.(Op)
+		return ok
+	case OpBoolLit, OpList:
+		_, ok := n.Value.(bool)
+		return ok
+	case OpIntLit:
+		_, ok := n.Value.(int64)
+		return ok
+	case OpFloatLit:
+		_, ok := n.Value.(float64)
+		return ok
+	case OpClosure, OpArrowFunc:
+		_, ok := n.Value.(*FuncType)
+		return ok
+	case OpCatch:
+		_, ok := n.Value.([]string)
+		return ok
+	default:
+		return true
+	}
+}
+
+func funcName(fn *FuncType) string {
+	if fn.Name == "" {
+		return "closure"
+	}
+	return fn.FullName()
+}
+
+// exprType returns the n value type.
+// It returns nil if the type is unknown.
+func exprType(n *Node) Type {
+	if n.Type != nil {
+		return n.Type
+	}
+	switch n.Op {
+	case OpParens:
+		return exprType(n.Args[0])
+	case OpBoolLit, OpNot:
+		return BoolType
+	case OpIntLit:
+		return IntType
+	case OpFloatLit:
+		return FloatType
+	case OpStringLit, OpInterpolatedString, OpConcat:
+		return StringType
+	}
+	return nil
+}
+
+// assignable reports whether a value of the src type can be
+// stored in a location of the dst type.
+func assignable(dst, src Type) bool {
+	if dst.String() == src.String() {
+		return true
+	}
+	if src, ok := src.(*EnumType); ok {
+		return assignable(dst, src.ValueType)
+	}
+	switch dst := dst.(type) {
+	case *ScalarType:
+		switch dst.Kind {
+		case ScalarMixed:
+			return true
+		case ScalarFloat:
+			// An int value is converted to float even in the strict mode.
+			src, ok := src.(*ScalarType)
+			return ok && src.Kind == ScalarInt
+		}
+		return false
+	case *EnumType:
+		return assignable(dst.ValueType, src)
+	case *NullableType:
+		if src, ok := src.(*NullableType); ok {
+			return assignable(dst.X, src.X)
+		}
+		return assignable(dst.X, src)
+	case *UnionType:
+		if src, ok := src.(*UnionType); ok {
+			return assignable(dst, src.X) && assignable(dst, src.Y)
+		}
+		return assignable(dst.X, src) || assignable(dst.Y, src)
+	case *ClassType:
+		src, ok := src.(*ClassType)
+		return ok && (src == dst || src.IsSubclassOf(dst))
+	case *ArrayType:
+		src, ok := src.(*ArrayType)
+		return ok && assignable(dst.KeyType(), src.KeyType()) && assignable(dst.Elem, src.Elem)
+	case *TupleType:
+		src, ok := src.(*TupleType)
+		if !ok || len(src.Elems) != len(dst.Elems) {
+			return false
+		}
+		for i := range dst.Elems {
+			if !assignable(dst.Elems[i], src.Elems[i]) {
+				return false
+			}
+		}
+		return true
+	case *FuncType:
+		// The callable hints don't check the signatures.
+		_, ok := src.(*FuncType)
+		return ok
+	}
+	return false
+}
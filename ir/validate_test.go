@@ -0,0 +1,215 @@
+package ir
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/quasilyte/phpsmith/phpdoc"
+)
+
+func TestValidate(t *testing.T) {
+	intType := &ScalarType{Kind: ScalarInt}
+	stringType := &ScalarType{Kind: ScalarString}
+
+	f := &FuncType{
+		Name:       "f",
+		Params:     []TypeField{{Name: "x", Type: intType}},
+		MinArgsNum: 1,
+		Result:     VoidType,
+	}
+	hinted := &FuncType{
+		Name:       "h",
+		Params:     []TypeField{{Name: "x", Type: intType, Flags: FlagTypeHinted}},
+		MinArgsNum: 1,
+		Result:     VoidType,
+	}
+	closureType := &FuncType{Params: []TypeField{{Name: "y", Type: intType}}, Result: VoidType}
+	intFunc := &FuncType{Result: intType}
+	floatFunc := &FuncType{Result: &ScalarType{Kind: ScalarFloat}}
+	intList := &ArrayType{Elem: intType}
+	stringMap := &ArrayType{Key: stringType, Elem: intType}
+
+	tests := []struct {
+		body *Node
+		want string
+	}{
+		{
+			body: NewBlock(
+				NewAssign(NewVar("a", intType), NewVar("x", intType)),
+				NewWhile(NewVar("a", intType), NewBlock(NewBreak(0))),
+				NewCall(NewName("f"), NewVar("a", intType)),
+				NewCall(NewName("strlen")),
+				NewAssign(NewVar("fn", closureType), NewClosure(closureType, NewBlock(
+					NewEcho(NewVar("y", intType), NewVar("a", intType)),
+				), NewVar("a", intType))),
+				NewEcho(NewArrowFunc(closureType, NewAdd(NewVar("a", intType), NewVar("y", intType)))),
+			),
+			want: "",
+		},
+		{
+			body: NewBlock(NewEcho(NewVar("a", intType))),
+			want: "[0 0] Var: $a is used before it's defined",
+		},
+		{
+			body: NewBlock(NewEcho(NewVar("x", stringType))),
+			want: "[0 0] Var: $x is used as string, but defined as [int]",
+		},
+		{
+			body: NewBlock(NewAssign(NewVar("a", intType), NewVar("a", intType))),
+			want: "[0 1] Var: $a is used before it's defined",
+		},
+		{
+			body: NewBlock(NewIf(NewBoolLit(true), NewBlock(NewContinue(0)))),
+			want: "[0 1 0] Continue: Continue 0 with 0 enclosing loops",
+		},
+		{
+			body: NewBlock(NewWhile(NewBoolLit(true), NewBlock(NewBreak(2)))),
+			want: "[0 1 0] Break: Break 2 with 1 enclosing loops",
+		},
+		{
+			body: NewBlock(NewCall(NewName("f"))),
+			want: "[0] Call: f called with 0 args, at least 1 are required",
+		},
+		{
+			body: NewBlock(&Node{Op: OpIntLit, Value: 10}),
+			want: "[0] IntLit: unexpected int value",
+		},
+		{
+			body: NewBlock(&Node{Op: OpIf, Args: []*Node{NewBoolLit(true)}}),
+			want: "[0] If: has 1 args",
+		},
+		{
+			body: NewBlock(NewEcho(NewClosure(closureType, NewBlock(NewEcho(NewVar("x", intType)))))),
+			want: "[0 0 0 0 0] Var: $x is used before it's defined",
+		},
+		{
+			body: NewBlock(
+				NewAssign(NewVar("xs", stringMap), &Node{Op: OpArrayLit, Type: stringMap}),
+				NewEcho(NewVar("xs", intList)),
+			),
+			want: "[1 0] Var: $xs is used as (int[]), but defined as [array<string,int>]",
+		},
+		{
+			body: NewBlock(NewAssign(NewVar("x", intType), NewStringLit("a"))),
+			want: "[0] Assign: string is assigned to int",
+		},
+		{
+			body: NewBlock(NewAssign(NewVar("xs", intList), &Node{Op: OpArrayLit, Type: stringMap})),
+			want: "[0] Assign: array<string,int> is assigned to (int[])",
+		},
+		{
+			body: NewBlock(NewCall(NewName("f"), NewParens(NewStringLit("a")))),
+			want: "[0 1] Parens: f $x param is int, but the arg is string",
+		},
+		{
+			body: NewBlock(NewCall(NewName("h"), NewStringLit("12"))),
+			want: "",
+		},
+		{
+			body: NewBlock(NewCall(NewName("h"), NewParens(NewStringLit("12")))),
+			want: "[0 1] Parens: h $x param is int, but the arg is string",
+		},
+		{
+			body: NewBlock(NewReturn(NewIntLit(1))),
+			want: "[0] Return: value is returned from f with void result",
+		},
+		{
+			body: NewBlock(NewEcho(NewClosure(intFunc, NewBlock(NewReturn(NewFloatLit(1.5)))))),
+			want: "[0 0 0 0] Return: float is returned from closure with int result",
+		},
+		{
+			body: NewBlock(NewEcho(NewArrowFunc(intFunc, NewStringLit("a")))),
+			want: "[0 0] ArrowFunc: string is returned from closure with int result",
+		},
+		{
+			body: NewBlock(NewEcho(NewClosure(floatFunc, NewBlock(NewReturn(NewIntLit(1)))))),
+			want: "",
+		},
+		{
+			body: NewBlock(&Node{
+				Op:    OpAssign,
+				Args:  []*Node{NewVar("a", intType), NewIntLit(1)},
+				Value: &phpdoc.VarTag{VarName: "$a", Type: "string"},
+			}),
+			want: "[0] Assign: @var string $a doesn't match $a type int",
+		},
+	}
+
+	for _, test := range tests {
+		files := []*File{{
+			Name:  "main.php",
+			Nodes: []RootNode{&RootFuncDecl{Type: hinted}, &RootFuncDecl{Type: f, Body: test.body}},
+		}}
+		var have []string
+		for _, err := range Validate(files) {
+			have = append(have, strings.TrimPrefix(err.Error(), "main.php: func f: "))
+		}
+		if strings.Join(have, "; ") != test.want {
+			t.Errorf("validate:\nhave: %q\nwant: %q", have, test.want)
+		}
+	}
+}
+
+func TestValidateInit(t *testing.T) {
+	class := &ClassType{
+		Name: "Foo",
+		Fields: []TypeField{
+			{Name: "a", Type: &NullableType{X: IntType}, Init: NewIntLit(1)},
+			{Name: "b", Type: IntType, Init: NewStringLit("1")},
+		},
+		Consts: []TypeField{
+			{Name: "C", Type: FloatType, Init: NewBoolLit(true)},
+		},
+	}
+	files := []*File{{
+		Name:  "main.php",
+		Nodes: []RootNode{&RootClassDecl{Type: class}},
+	}}
+	var have []string
+	for _, err := range Validate(files) {
+		have = append(have, err.Error())
+	}
+	want := []string{
+		"main.php: const Foo::C: [] BoolLit: initializer is bool, but the type is float",
+		"main.php: field Foo::$b: [] StringLit: initializer is string, but the type is int",
+	}
+	if strings.Join(have, "; ") != strings.Join(want, "; ") {
+		t.Errorf("validate:\nhave: %q\nwant: %q", have, want)
+	}
+}
+
+func TestValidateAbstractMethods(t *testing.T) {
+	iface := &ClassType{Name: "Iface", Flags: FlagInterface}
+	iface.Methods = []*FuncType{{Name: "g", Flags: FlagAbstract, Class: iface}}
+	base := &ClassType{Name: "Base", Flags: FlagAbstract, Interfaces: []*ClassType{iface}}
+	base.Methods = []*FuncType{
+		{Name: "f", Flags: FlagAbstract, Class: base},
+		{Name: "g", Flags: FlagPublic, Class: base},
+	}
+	derived := &ClassType{Name: "Derived", Parent: base}
+	impl := &ClassType{Name: "Impl", Parent: base}
+	impl.Methods = []*FuncType{{Name: "f", Flags: FlagPublic, Class: impl}}
+	other := &ClassType{Name: "Other", Interfaces: []*ClassType{iface}}
+
+	files := []*File{{
+		Name: "main.php",
+		Nodes: []RootNode{
+			&RootClassDecl{Type: iface},
+			&RootClassDecl{Type: base},
+			&RootClassDecl{Type: derived},
+			&RootClassDecl{Type: impl},
+			&RootClassDecl{Type: other},
+		},
+	}}
+	var have []string
+	for _, err := range Validate(files) {
+		have = append(have, err.Error())
+	}
+	want := []string{
+		"main.php: class Derived: [] <decl>: Base::f is not implemented",
+		"main.php: class Other: [] <decl>: Iface::g is not implemented",
+	}
+	if strings.Join(have, "; ") != strings.Join(want, "; ") {
+		t.Errorf("validate:\nhave: %q\nwant: %q", have, want)
+	}
+}
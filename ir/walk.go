@@ -0,0 +1,182 @@
+package ir
+
+// Cursor describes the position of the node being visited.
+type Cursor struct {
+	// Root is the root node that contains the visited node.
+	// It's nil when a standalone node tree is traversed.
+	Root RootNode
+
+	// Member is a class member name (method, field or constant)
+	// for the nodes inside the class declarations.
+	Member string
+
+	// Parent is nil for the top nodes: function bodies,
+	// field and const initializers and root statements.
+	Parent *Node
+
+	// Index is the node index inside Parent.Args, it's -1 for the top nodes.
+	Index int
+
+	// Path is a list of Args indexes from the top node.
+	// It's reused during the traversal, copy it to retain.
+	Path []int
+}
+
+// WalkFunc is called for every visited node.
+// If it returns false, the node children are not visited.
+type WalkFunc func(n *Node, c *Cursor) bool
+
+// RewriteFunc is called for every visited node.
+// A non-nil result replaces the visited node.
+type RewriteFunc func(n *Node, c *Cursor) *Node
+
+// Inspect traverses n and its children in depth-first order.
+// If f returns false, the node children are not visited.
+// Nil Args (like skipped OpList elements) are not visited.
+func Inspect(n *Node, f func(*Node) bool) {
+	if !f(n) {
+		return
+	}
+	for _, arg := range n.Args {
+		if arg != nil {
+			Inspect(arg, f)
+		}
+	}
+}
+
+// Walk traverses all nodes of the root node in depth-first order.
+// Function and method bodies, class field and const initializers,
+// root statements and declare values are visited.
+func Walk(root RootNode, visit WalkFunc) {
+	w := &walker{visit: visit}
+	w.cursor.Root = root
+	forEachTopNode(root, func(member string, n *Node) *Node {
+		w.enterTop(member)
+		w.walk(n)
+		return n
+	})
+}
+
+// WalkNode is like Walk, but traverses a standalone node tree.
+func WalkNode(n *Node, visit WalkFunc) {
+	w := &walker{visit: visit}
+	w.enterTop("")
+	w.walk(n)
+}
+
+// Rewrite traverses all nodes of the root node like Walk does,
+// replacing the nodes with the non-nil pre and post results.
+//
+// pre is called before the node children are visited; if it returns
+// a replacement, the replacement children are visited instead.
+// post is called after the children are visited.
+// Either function can be nil.
+//
+// The nodes are updated in place.
+func Rewrite(root RootNode, pre, post RewriteFunc) {
+	w := &walker{pre: pre, post: post}
+	w.cursor.Root = root
+	forEachTopNode(root, func(member string, n *Node) *Node {
+		w.enterTop(member)
+		return w.rewrite(n)
+	})
+}
+
+// RewriteNode is like Rewrite, but rewrites a standalone node tree.
+// It returns the new tree top node.
+func RewriteNode(n *Node, pre, post RewriteFunc) *Node {
+	w := &walker{pre: pre, post: post}
+	w.enterTop("")
+	return w.rewrite(n)
+}
+
+// forEachTopNode calls f for every top node of the root node
+// and stores the f results back.
+func forEachTopNode(root RootNode, f func(member string, n *Node) *Node) {
+	switch root := root.(type) {
+	case *RootFuncDecl:
+		if root.Body != nil {
+			root.Body = f("", root.Body)
+		}
+	case *RootStmt:
+		root.X = f("", root.X)
+	case *RootDeclare:
+		root.Value = f("", root.Value)
+	case *RootClassDecl:
+		typ := root.Type
+		for i := range typ.Consts {
+			if init, ok := typ.Consts[i].Init.(*Node); ok {
+				typ.Consts[i].Init = f(typ.Consts[i].Name, init)
+			}
+		}
+		for i := range typ.Fields {
+			if init, ok := typ.Fields[i].Init.(*Node); ok {
+				typ.Fields[i].Init = f(typ.Fields[i].Name, init)
+			}
+		}
+		for _, m := range root.Methods {
+			if m.Body != nil {
+				m.Body = f(m.Type.Name, m.Body)
+			}
+		}
+	}
+}
+
+type walker struct {
+	visit WalkFunc
+	pre   RewriteFunc
+	post  RewriteFunc
+
+	cursor Cursor
+}
+
+func (w *walker) enterTop(member string) {
+	w.cursor.Member = member
+	w.cursor.Parent = nil
+	w.cursor.Index = -1
+	w.cursor.Path = w.cursor.Path[:0]
+}
+
+func (w *walker) walk(n *Node) {
+	if !w.visit(n, &w.cursor) {
+		return
+	}
+	parent, index := w.cursor.Parent, w.cursor.Index
+	w.cursor.Parent = n
+	for i, arg := range n.Args {
+		if arg == nil {
+			continue
+		}
+		w.cursor.Index = i
+		w.cursor.Path = append(w.cursor.Path, i)
+		w.walk(arg)
+		w.cursor.Path = w.cursor.Path[:len(w.cursor.Path)-1]
+	}
+	w.cursor.Parent, w.cursor.Index = parent, index
+}
+
+func (w *walker) rewrite(n *Node) *Node {
+	if w.pre != nil {
+		if x := w.pre(n, &w.cursor); x != nil {
+			n = x
+		}
+	}
+	parent, index := w.cursor.Parent, w.cursor.Index
+	w.cursor.Parent = n
+	for i, arg := range n.Args {
+		if arg == nil {
+			continue
+		}
+		w.cursor.Index = i
+		w.cursor.Path = append(w.cursor.Path, i)
+		n.Args[i] = w.rewrite(arg)
+		w.cursor.Path = w.cursor.Path[:len(w.cursor.Path)-1]
+	}
+	w.cursor.Parent, w.cursor.Index = parent, index
+	if w.post != nil {
+		if x := w.post(n, &w.cursor); x != nil {
+			n = x
+		}
+	}
+	return n
+}
@@ -0,0 +1,60 @@
+package ir
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestWalk(t *testing.T) {
+	intType := &ScalarType{Kind: ScalarInt}
+	class := &ClassType{
+		Name:   "Foo",
+		Consts: []TypeField{{Name: "C", Type: intType, Init: NewIntLit(1)}},
+		Fields: []TypeField{{Name: "x", Type: intType}, {Name: "y", Type: intType, Init: NewIntLit(2)}},
+	}
+	method := &FuncType{Name: "f", Class: class}
+	decl := &RootClassDecl{
+		Type: class,
+		Methods: []*RootFuncDecl{
+			{Type: method, Body: NewBlock(NewEcho(NewAdd(NewVar("a", intType), NewIntLit(3))))},
+		},
+	}
+
+	var visited []string
+	Walk(decl, func(n *Node, c *Cursor) bool {
+		parent := "nil"
+		if c.Parent != nil {
+			parent = c.Parent.Op.String()
+		}
+		visited = append(visited, fmt.Sprintf("%s:%s%v<%s>", c.Member, n.Op, c.Path, parent))
+		return n.Op != OpAdd
+	})
+	want := []string{
+		"C:IntLit[]<nil>",
+		"y:IntLit[]<nil>",
+		"f:Block[]<nil>",
+		"f:Echo[0]<Block>",
+		"f:Add[0 0]<Echo>",
+	}
+	if have := strings.Join(visited, " "); have != strings.Join(want, " ") {
+		t.Fatalf("walk:\nhave: %s\nwant: %s", have, strings.Join(want, " "))
+	}
+
+	Rewrite(decl, nil, func(n *Node, c *Cursor) *Node {
+		if n.Op == OpIntLit {
+			return NewIntLit(n.Value.(int64) * 10)
+		}
+		return nil
+	})
+	var values []int64
+	Walk(decl, func(n *Node, c *Cursor) bool {
+		if n.Op == OpIntLit {
+			values = append(values, n.Value.(int64))
+		}
+		return true
+	})
+	if have := fmt.Sprint(values); have != "[10 20 30]" {
+		t.Fatalf("rewrite: have %s values, want [10 20 30]", have)
+	}
+}
@@ -0,0 +1,91 @@
+package irgen
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/quasilyte/phpsmith/ir"
+	"github.com/quasilyte/phpsmith/irprint"
+	"github.com/quasilyte/phpsmith/randutil"
+)
+
+func printFiles(files []*File) []byte {
+	var buf bytes.Buffer
+	for _, f := range files {
+		irprint.FprintFile(&buf, f.Name, f.Nodes, &irprint.Config{})
+	}
+	return buf.Bytes()
+}
+
+func TestChoicesReplay(t *testing.T) {
+	for seed := int64(1); seed <= 5; seed++ {
+		rec := &randutil.ChoiceRecorder{}
+		recorded := CreateProgram(&Config{
+			Rand:    rec.Rand(rand.NewSource(seed)),
+			SubRand: rec.SubRand,
+		})
+		want := printFiles(recorded.Files)
+
+		// The recorded choices repeat the program exactly.
+		rep := randutil.NewChoiceReplayer(rec.Choices)
+		replayed := CreateProgram(&Config{Rand: rep.Rand(), SubRand: rep.SubRand})
+		if have := printFiles(replayed.Files); !bytes.Equal(have, want) {
+			t.Fatalf("seed %d: replayed program differs from the recorded one", seed)
+		}
+		if rep.Consumed() != len(rec.Choices) {
+			t.Fatalf("seed %d: replayed %d choices out of %d", seed, rep.Consumed(), len(rec.Choices))
+		}
+
+		// The shortened and zeroed choices still produce valid programs.
+		shrunk := append([]uint64(nil), rec.Choices[:len(rec.Choices)/3]...)
+		for i := 0; i < len(shrunk); i += 3 {
+			shrunk[i] = 0
+		}
+		rep = randutil.NewChoiceReplayer(shrunk)
+		program := CreateProgram(&Config{Rand: rep.Rand(), SubRand: rep.SubRand})
+		for _, err := range ir.Validate(program.Files) {
+			t.Errorf("seed %d: shrunk choices: %v", seed, err)
+		}
+	}
+}
+
+func TestShrinkChoicesReplay(t *testing.T) {
+	hasForeach := func(files []*File) bool {
+		found := false
+		for _, f := range files {
+			for _, n := range f.Nodes {
+				ir.Walk(n, func(n *ir.Node, c *ir.Cursor) bool {
+					found = found || n.Op == ir.OpForeach
+					return !found
+				})
+			}
+		}
+		return found
+	}
+	replay := func(choices []uint64) *Program {
+		rep := randutil.NewChoiceReplayer(choices)
+		return CreateProgram(&Config{Rand: rep.Rand(), SubRand: rep.SubRand})
+	}
+
+	rec := &randutil.ChoiceRecorder{}
+	CreateProgram(&Config{
+		Rand:    rec.Rand(rand.NewSource(1)),
+		SubRand: rec.SubRand,
+	})
+	shrunk := randutil.ShrinkChoices(rec.Choices, func(choices []uint64) bool {
+		return hasForeach(replay(choices).Files)
+	})
+	if len(shrunk) >= len(rec.Choices) {
+		t.Fatalf("choices are not shrunk: %d of %d left", len(shrunk), len(rec.Choices))
+	}
+
+	// The shrunk choices are replayed to a valid program that keeps the property.
+	program := replay(shrunk)
+	if !hasForeach(program.Files) {
+		t.Fatalf("shrunk choices lost the foreach statement")
+	}
+	for _, err := range ir.Validate(program.Files) {
+		t.Errorf("shrunk choices: %v", err)
+	}
+}
@@ -2,7 +2,9 @@ package irgen
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
+	"strconv"
 
 	"github.com/quasilyte/phpsmith/ir"
 	"github.com/quasilyte/phpsmith/randutil"
@@ -22,11 +24,27 @@ type exprGenerator struct {
 
 	exprDepth int
 
+	closureParamSeq int
+
+	// nativeHints tells whether the closures get the native type hints,
+	// it follows the program typing style.
+	nativeHints bool
+
+	// strictTypes tells whether the file being generated declares strict_types.
+	strictTypes bool
+
+	// currentClass is a class of the method being generated, if any.
+	// It's used to check the members visibility.
+	currentClass *ir.ClassType
+
 	condChoices   exprChoiceList
 	boolChoices   exprChoiceList
 	intChoices    exprChoiceList
 	floatChoices  exprChoiceList
 	stringChoices exprChoiceList
+
+	// choiceOps counts the ops produced by every expression choice.
+	choiceOps map[string]map[ir.Op]int
 }
 
 type exprChoiceList struct {
@@ -36,11 +54,19 @@ type exprChoiceList struct {
 }
 
 type exprChoice struct {
+	// name is a list-local choice name, it's prefixed
+	// with the list name by makeChoicesList.
+	name string
+
 	freq     int
 	generate func() *ir.Node
 	fallback func() *ir.Node
 }
 
+// exprWeightScale multiplies the choice frequencies when the weights are set,
+// so the fractional weights make a difference.
+const exprWeightScale = 4
+
 func newExprGenerator(config *Config, s *scope, symtab *symbolTable) *exprGenerator {
 	g := &exprGenerator{
 		config:         config,
@@ -48,12 +74,23 @@ func newExprGenerator(config *Config, s *scope, symtab *symbolTable) *exprGenera
 		symtab:         symtab,
 		rand:           config.Rand,
 		valueGenerator: newValueGenerator(config.Rand),
+		choiceOps:      make(map[string]map[ir.Op]int),
 	}
 
-	makeChoicesList := func(fallback func() *ir.Node, options []exprChoice) exprChoiceList {
+	makeChoicesList := func(name string, fallback func() *ir.Node, options []exprChoice) exprChoiceList {
 		indexes := make([]uint16, 0, len(options)*4)
-		for i, o := range options {
-			for j := 0; j < o.freq; j++ {
+		for i := range options {
+			o := &options[i]
+			o.name = name + "/" + o.name
+			freq := o.freq
+			if config.ExprWeights != nil {
+				w, ok := config.ExprWeights[o.name]
+				if !ok {
+					w = 1
+				}
+				freq = int(math.Round(float64(freq*exprWeightScale) * w))
+			}
+			for j := 0; j < freq; j++ {
 				indexes = append(indexes, uint16(i))
 			}
 		}
@@ -108,65 +145,74 @@ func newExprGenerator(config *Config, s *scope, symtab *symbolTable) *exprGenera
 		}
 	}
 
-	g.condChoices = makeChoicesList(g.boolLit, []exprChoice{
-		{freq: 2, generate: g.boolFieldAccess, fallback: g.boolLit},
-		{freq: 3, generate: cmpOpGenerator(ir.OpEqual2)},
-		{freq: 3, generate: cmpOpGenerator(ir.OpEqual3)},
-		{freq: 4, generate: binaryOpGenerator(ir.OpAnd, nil, g.boolValue)},
-		{freq: 4, generate: binaryOpGenerator(ir.OpOr, nil, g.boolValue)},
-		{freq: 4, generate: unaryOpGenerator(ir.OpNot, g.condValue)},
-		{freq: 6, generate: g.boolCall},
-		{freq: 1, generate: g.boolLit},
+	g.condChoices = makeChoicesList("cond", g.boolLit, []exprChoice{
+		{name: "field_access", freq: 2, generate: g.boolFieldAccess, fallback: g.boolLit},
+		{name: "equal2", freq: 3, generate: cmpOpGenerator(ir.OpEqual2)},
+		{name: "equal3", freq: 3, generate: cmpOpGenerator(ir.OpEqual3)},
+		{name: "and", freq: 4, generate: binaryOpGenerator(ir.OpAnd, nil, g.boolValue)},
+		{name: "or", freq: 4, generate: binaryOpGenerator(ir.OpOr, nil, g.boolValue)},
+		{name: "not", freq: 4, generate: unaryOpGenerator(ir.OpNot, g.condValue)},
+		{name: "call", freq: 6, generate: g.boolCall},
+		{name: "lit", freq: 1, generate: g.boolLit},
+		{name: "type_check", freq: 2, generate: g.typeCheck, fallback: g.boolLit},
 	})
 
-	g.boolChoices = makeChoicesList(g.boolLit, []exprChoice{
-		{freq: 1, generate: cmpOpGenerator(ir.OpEqual2)},
-		{freq: 1, generate: cmpOpGenerator(ir.OpEqual3)},
-		{freq: 2, generate: g.boolFieldAccess, fallback: g.boolLit},
-		{freq: 3, generate: binaryOpGenerator(ir.OpAnd, nil, g.boolValue)},
-		{freq: 3, generate: binaryOpGenerator(ir.OpOr, nil, g.boolValue)},
-		{freq: 3, generate: g.boolLit},
-		{freq: 4, generate: unaryOpGenerator(ir.OpNot, g.condValue)},
-		{freq: 4, generate: g.boolCall},
+	g.boolChoices = makeChoicesList("bool", g.boolLit, []exprChoice{
+		{name: "equal2", freq: 1, generate: cmpOpGenerator(ir.OpEqual2)},
+		{name: "equal3", freq: 1, generate: cmpOpGenerator(ir.OpEqual3)},
+		{name: "field_access", freq: 2, generate: g.boolFieldAccess, fallback: g.boolLit},
+		{name: "and", freq: 3, generate: binaryOpGenerator(ir.OpAnd, nil, g.boolValue)},
+		{name: "or", freq: 3, generate: binaryOpGenerator(ir.OpOr, nil, g.boolValue)},
+		{name: "lit", freq: 3, generate: g.boolLit},
+		{name: "not", freq: 4, generate: unaryOpGenerator(ir.OpNot, g.condValue)},
+		{name: "call", freq: 4, generate: g.boolCall},
+		{name: "closure_call", freq: 1, generate: g.boolClosureCall, fallback: g.boolLit},
+		{name: "narrowed", freq: 1, generate: g.boolNarrowed, fallback: g.boolLit},
 	})
 
-	g.intChoices = makeChoicesList(g.intLit, []exprChoice{
-		{freq: 1, generate: g.intTernary},
-		{freq: 2, generate: withCast(binaryOpGenerator(ir.OpAdd, ir.IntType, g.intValue), ir.IntType)},
-		{freq: 2, generate: binaryOpGenerator(ir.OpSub, ir.IntType, g.intValue)},
-		{freq: 1, generate: withCast(binaryOpGenerator(ir.OpMul, ir.IntType, g.intValue), ir.IntType)},
-		{freq: 1, generate: binaryOpGenerator(ir.OpBitAnd, ir.IntType, g.intValue)},
-		{freq: 1, generate: binaryOpGenerator(ir.OpBitOr, ir.IntType, g.intValue)},
-		{freq: 1, generate: binaryOpGenerator(ir.OpBitXor, ir.IntType, g.intValue)},
-		{freq: 1, generate: withCast(binaryOpGenerator(ir.OpExp, ir.IntType, g.intValue), ir.IntType)},
-		{freq: 1, generate: withCast(binaryOpGenerator(ir.OpDiv, ir.IntType, g.intValue), ir.IntType)},
-		{freq: 1, generate: withCast(binaryOpGenerator(ir.OpMod, ir.IntType, g.intValue), ir.IntType)},
-		{freq: 2, generate: g.intFieldAccess, fallback: g.intLit},
-		{freq: 2, generate: g.intNegation},
-		{freq: 2, generate: g.intCast},
-		{freq: 7, generate: g.intCall},
-		{freq: 4, generate: g.intLit},
+	g.intChoices = makeChoicesList("int", g.intLit, []exprChoice{
+		{name: "ternary", freq: 1, generate: g.intTernary},
+		{name: "add", freq: 2, generate: withCast(binaryOpGenerator(ir.OpAdd, ir.IntType, g.intValue), ir.IntType)},
+		{name: "sub", freq: 2, generate: withCast(binaryOpGenerator(ir.OpSub, ir.IntType, g.intValue), ir.IntType)},
+		{name: "mul", freq: 1, generate: withCast(binaryOpGenerator(ir.OpMul, ir.IntType, g.intValue), ir.IntType)},
+		{name: "bit_and", freq: 1, generate: binaryOpGenerator(ir.OpBitAnd, ir.IntType, g.intValue)},
+		{name: "bit_or", freq: 1, generate: binaryOpGenerator(ir.OpBitOr, ir.IntType, g.intValue)},
+		{name: "bit_xor", freq: 1, generate: binaryOpGenerator(ir.OpBitXor, ir.IntType, g.intValue)},
+		{name: "exp", freq: 1, generate: withCast(binaryOpGenerator(ir.OpExp, ir.IntType, g.intValue), ir.IntType)},
+		{name: "div", freq: 1, generate: withCast(binaryOpGenerator(ir.OpDiv, ir.IntType, g.intValue), ir.IntType)},
+		{name: "mod", freq: 1, generate: withCast(binaryOpGenerator(ir.OpMod, ir.IntType, g.intValue), ir.IntType)},
+		{name: "field_access", freq: 2, generate: g.intFieldAccess, fallback: g.intLit},
+		{name: "negation", freq: 2, generate: withCast(g.intNegation, ir.IntType)},
+		{name: "cast", freq: 2, generate: g.intCast},
+		{name: "call", freq: 7, generate: g.intCall},
+		{name: "closure_call", freq: 1, generate: g.intClosureCall, fallback: g.intLit},
+		{name: "narrowed", freq: 2, generate: g.intNarrowed, fallback: g.intLit},
+		{name: "lit", freq: 4, generate: g.intLit},
 	})
 
-	g.floatChoices = makeChoicesList(g.floatLit, []exprChoice{
-		{freq: 1, generate: g.floatTernary},
-		{freq: 2, generate: binaryOpGenerator(ir.OpAdd, ir.FloatType, g.floatValue)},
-		{freq: 2, generate: binaryOpGenerator(ir.OpSub, ir.FloatType, g.floatValue)},
-		{freq: 2, generate: g.floatFieldAccess, fallback: g.floatLit},
-		{freq: 1, generate: binaryOpGenerator(ir.OpDiv, ir.FloatType, g.floatValue)},
-		{freq: 1, generate: binaryOpGenerator(ir.OpMul, ir.FloatType, g.floatValue)},
-		{freq: 5, generate: g.floatCall},
-		{freq: 5, generate: g.floatLit},
+	g.floatChoices = makeChoicesList("float", g.floatLit, []exprChoice{
+		{name: "ternary", freq: 1, generate: g.floatTernary},
+		{name: "add", freq: 2, generate: binaryOpGenerator(ir.OpAdd, ir.FloatType, g.floatValue)},
+		{name: "sub", freq: 2, generate: binaryOpGenerator(ir.OpSub, ir.FloatType, g.floatValue)},
+		{name: "field_access", freq: 2, generate: g.floatFieldAccess, fallback: g.floatLit},
+		{name: "div", freq: 1, generate: binaryOpGenerator(ir.OpDiv, ir.FloatType, g.floatValue)},
+		{name: "mul", freq: 1, generate: binaryOpGenerator(ir.OpMul, ir.FloatType, g.floatValue)},
+		{name: "call", freq: 5, generate: g.floatCall},
+		{name: "closure_call", freq: 1, generate: g.floatClosureCall, fallback: g.floatLit},
+		{name: "narrowed", freq: 2, generate: g.floatNarrowed, fallback: g.floatLit},
+		{name: "lit", freq: 5, generate: g.floatLit},
 	})
 
-	g.stringChoices = makeChoicesList(g.stringLit, []exprChoice{
-		{freq: 2, generate: g.stringCast},
-		{freq: 2, generate: g.stringFieldAccess, fallback: g.stringLit},
-		{freq: 5, generate: g.stringCall},
-		{freq: 4, generate: binaryOpGenerator(ir.OpConcat, ir.StringType, g.stringValue)},
-		{freq: 5, generate: g.stringLit},
-		{freq: 5, generate: g.interpolatedString},
-		{freq: 2, generate: g.stringIndex, fallback: g.interpolatedString},
+	g.stringChoices = makeChoicesList("string", g.stringLit, []exprChoice{
+		{name: "cast", freq: 2, generate: g.stringCast},
+		{name: "field_access", freq: 2, generate: g.stringFieldAccess, fallback: g.stringLit},
+		{name: "call", freq: 5, generate: g.stringCall},
+		{name: "closure_call", freq: 1, generate: g.stringClosureCall, fallback: g.stringLit},
+		{name: "narrowed", freq: 2, generate: g.stringNarrowed, fallback: g.stringLit},
+		{name: "concat", freq: 4, generate: binaryOpGenerator(ir.OpConcat, ir.StringType, g.stringValue)},
+		{name: "lit", freq: 5, generate: g.stringLit},
+		{name: "interpolated", freq: 5, generate: g.interpolatedString},
+		{name: "index", freq: 2, generate: g.stringIndex, fallback: g.interpolatedString},
 	})
 
 	return g
@@ -181,10 +227,10 @@ func (g *exprGenerator) pickType(depth int) ir.Type {
 		return g.PickScalarType()
 	}
 
-	switch g.rand.Intn(7 + depth*3) {
+	switch g.rand.Intn(10 + depth*3) {
 	case 0:
 		elemType := g.pickType(depth + 1)
-		return &ir.ArrayType{Elem: elemType}
+		return &ir.ArrayType{Key: g.pickArrayKeyType(), Elem: elemType}
 
 	case 1:
 		return g.pickTupleType(depth + 2)
@@ -195,13 +241,36 @@ func (g *exprGenerator) pickType(depth int) ir.Type {
 	case 3, 4:
 		return g.maybePickClassType(depth)
 
+	case 5:
+		return g.PickFuncType()
+
+	case 6:
+		return &ir.NullableType{X: g.pickUnionMemberType()}
+
+	case 7:
+		return g.pickUnionType()
+
 	default:
 		return g.PickScalarType()
 	}
 }
 
+// pickArrayKeyType returns nil for lists most of the time.
+func (g *exprGenerator) pickArrayKeyType() ir.Type {
+	switch g.rand.Intn(8) {
+	case 0:
+		return ir.IntType
+	case 1:
+		return ir.StringType
+	case 2:
+		return ir.MixedType
+	default:
+		return nil
+	}
+}
+
 func (g *exprGenerator) maybePickClassType(depth int) ir.Type {
-	c := g.symtab.PickRandomClass()
+	c := g.symtab.PickRandomClass(g.rand)
 	if c != nil {
 		return c
 	}
@@ -219,6 +288,47 @@ func (g *exprGenerator) pickTupleType(depth int) ir.Type {
 	return tuple
 }
 
+func (g *exprGenerator) pickUnionType() *ir.UnionType {
+	x := g.pickUnionMemberType()
+	for {
+		y := g.pickUnionMemberType()
+		if !typesIdentical(x, y) {
+			return &ir.UnionType{X: x, Y: y}
+		}
+	}
+}
+
+// pickUnionMemberType returns a type that can be checked at run time,
+// so the union or nullable values of that type can be narrowed.
+func (g *exprGenerator) pickUnionMemberType() ir.Type {
+	switch g.rand.Intn(6) {
+	case 0:
+		return &ir.ArrayType{Elem: g.PickScalarType()}
+	case 1:
+		if c := g.symtab.PickRandomClass(g.rand); c != nil {
+			return c
+		}
+		return g.PickScalarType()
+	default:
+		return g.PickScalarType()
+	}
+}
+
+// PickFuncType returns an anonymous callable type.
+// Closure params and results are limited to scalars, so they can always be type-hinted.
+func (g *exprGenerator) PickFuncType() *ir.FuncType {
+	numParams := randutil.IntRange(g.rand, 0, 3)
+	fn := &ir.FuncType{
+		Params:     make([]ir.TypeField, numParams),
+		MinArgsNum: numParams,
+		Result:     g.PickScalarType(),
+	}
+	for i := range fn.Params {
+		fn.Params[i].Type = g.PickScalarType()
+	}
+	return fn
+}
+
 func (g *exprGenerator) PickEnumType() ir.Type {
 	valueType := g.PickScalarTypeNoBool().(*ir.ScalarType)
 	enumType := &ir.EnumType{ValueType: valueType}
@@ -271,7 +381,7 @@ func (g *exprGenerator) GenerateConstValueOfType(typ ir.Type) *ir.Node {
 		return g.enumValue(typ)
 
 	case *ir.ArrayType:
-		return g.constArrayValue(typ.Elem)
+		return g.constArrayValue(typ)
 
 	case *ir.TupleType:
 		return g.constTupleValue(typ)
@@ -279,6 +389,21 @@ func (g *exprGenerator) GenerateConstValueOfType(typ ir.Type) *ir.Node {
 	case *ir.ClassType:
 		return ir.NewName("null")
 
+	case *ir.FuncType:
+		return ir.NewArrowFunc(g.closureParams(typ), g.GenerateConstValueOfType(typ.Result))
+
+	case *ir.NullableType:
+		if randutil.Chance(g.rand, 0.3) {
+			return ir.NewName("null")
+		}
+		return g.GenerateConstValueOfType(typ.X)
+
+	case *ir.UnionType:
+		if randutil.Bool(g.rand) {
+			return g.GenerateConstValueOfType(typ.X)
+		}
+		return g.GenerateConstValueOfType(typ.Y)
+
 	default:
 		panic(fmt.Sprintf("unexpected %T type", typ))
 	}
@@ -334,6 +459,21 @@ func (g *exprGenerator) GenerateValueOfType(typ ir.Type) *ir.Node {
 	case *ir.ClassType:
 		return g.classValue(typ)
 
+	case *ir.FuncType:
+		return g.closureValue(typ)
+
+	case *ir.NullableType:
+		if randutil.Chance(g.rand, 0.25) {
+			return ir.NewName("null")
+		}
+		return g.GenerateValueOfType(typ.X)
+
+	case *ir.UnionType:
+		if randutil.Bool(g.rand) {
+			return g.GenerateValueOfType(typ.X)
+		}
+		return g.GenerateValueOfType(typ.Y)
+
 	default:
 		panic(fmt.Sprintf("unexpected %T type", typ))
 	}
@@ -343,6 +483,10 @@ func (g *exprGenerator) chooseExpr(list *exprChoiceList) *ir.Node {
 	if g.exprDepth > 10 {
 		return list.fallback()
 	}
+	if len(list.indexMap) == 0 {
+		// All choices are disabled by the weights.
+		return list.fallback()
+	}
 	g.exprDepth++
 	defer func() { g.exprDepth-- }()
 
@@ -354,6 +498,7 @@ func (g *exprGenerator) chooseExpr(list *exprChoiceList) *ir.Node {
 			n = option.fallback()
 		}
 		if n != nil {
+			g.recordChoice(option.name, n.Op)
 			addParens := g.rand.Intn(10) <= 3
 			if addParens {
 				n = ir.NewParens(n)
@@ -363,6 +508,15 @@ func (g *exprGenerator) chooseExpr(list *exprChoiceList) *ir.Node {
 	}
 }
 
+func (g *exprGenerator) recordChoice(name string, op ir.Op) {
+	ops := g.choiceOps[name]
+	if ops == nil {
+		ops = make(map[ir.Op]int)
+		g.choiceOps[name] = ops
+	}
+	ops[op]++
+}
+
 func (g *exprGenerator) condValue() *ir.Node {
 	return g.chooseExpr(&g.condChoices)
 }
@@ -458,9 +612,47 @@ func (g *exprGenerator) fieldAccessOfType(typ ir.Type) *ir.Node {
 		return nil
 	}
 	ref := list[g.rand.Intn(len(list))]
-	instance := g.maybeAddParens(g.GenerateValueOfType(ref.class))
-	field := ref.class.Fields[ref.index]
-	return ir.NewMemberAccess(instance, field.Name)
+	field := ref.Get()
+	if !g.canAccess(field.Flags, ref.class) {
+		return nil
+	}
+	if ref.isConst {
+		return ir.NewStaticMemberAccess(g.classRef(ref.class, true), field.Name)
+	}
+	if field.Flags.IsStatic() {
+		return ir.NewStaticMemberAccess(g.classRef(ref.class, !field.Flags.IsPrivate()), "$"+field.Name)
+	}
+	return ir.NewMemberAccess(g.objectValue(ref.class), field.Name)
+}
+
+// canAccess reports whether a class member is visible from the current context.
+func (g *exprGenerator) canAccess(flags ir.TypeFlags, class *ir.ClassType) bool {
+	cur := g.currentClass
+	switch {
+	case flags.IsPrivate():
+		return cur == class
+	case flags.IsProtected():
+		return cur != nil && (cur == class || cur.IsSubclassOf(class) || class.IsSubclassOf(cur))
+	default:
+		return true
+	}
+}
+
+// classRef returns a class reference to be used in a static member access.
+// Inside the class methods, it can be one of the self/static/parent keywords.
+func (g *exprGenerator) classRef(c *ir.ClassType, allowStatic bool) *ir.Node {
+	cur := g.currentClass
+	if cur == nil || !(cur == c || cur.IsSubclassOf(c)) {
+		return ir.NewName(c.Name)
+	}
+	options := []string{c.Name, "self"}
+	if allowStatic {
+		options = append(options, "static")
+	}
+	if cur.Parent != nil && (cur.Parent == c || cur.Parent.IsSubclassOf(c)) {
+		options = append(options, "parent")
+	}
+	return ir.NewName(randutil.Elem(g.rand, options))
 }
 
 func (g *exprGenerator) interpolatedString() *ir.Node {
@@ -502,21 +694,17 @@ func (g *exprGenerator) callOfType(fn *ir.FuncType) *ir.Node {
 	g.exprDepth++
 	defer func() { g.exprDepth-- }()
 
-	numArgs := randutil.IntRange(g.rand, fn.MinArgsNum, len(fn.Params))
-	callArgs := make([]*ir.Node, numArgs)
-	for i := range callArgs {
-		arg := g.GenerateValueOfType(fn.Params[i].Type)
-		if fn.Params[i].Strict {
-			arg = &ir.Node{Op: ir.OpCast, Args: []*ir.Node{g.maybeAddParens(arg)}, Type: fn.Params[i].Type}
-		}
-		callArgs[i] = arg
+	callArgs, ok := g.callArgs(fn)
+	if !ok {
+		return nil
 	}
 	var funcExpr *ir.Node
 	if fn.Class == nil {
 		funcExpr = ir.NewName(fn.Name)
+	} else if fn.Flags.IsStatic() {
+		funcExpr = ir.NewStaticMemberAccess(g.classRef(fn.Class, true), fn.Name)
 	} else {
-		instance := g.maybeAddParens(g.GenerateValueOfType(fn.Class))
-		funcExpr = ir.NewMemberAccess(instance, fn.Name)
+		funcExpr = ir.NewMemberAccess(g.objectValue(fn.Class), fn.Name)
 	}
 	result := ir.NewCall(funcExpr, callArgs...)
 	if fn.NeedCast {
@@ -525,20 +713,204 @@ func (g *exprGenerator) callOfType(fn *ir.FuncType) *ir.Node {
 	return result
 }
 
-func (g *exprGenerator) boolCall() *ir.Node {
-	return g.callOfType(g.symtab.boolFuncs[g.rand.Intn(len(g.symtab.boolFuncs))])
+// callArgs generates the fn call arguments.
+// It reports false if there are no variables to pass to the by-reference params.
+// coercibleArgChance returns a probability of passing a coercible arg
+// to a type-hinted param. In the strict mode such a call throws
+// a TypeError that leaves the current main function call, so it's rare.
+func (g *exprGenerator) coercibleArgChance() float64 {
+	if g.strictTypes {
+		return 0.01
+	}
+	return 0.1
+}
+
+// coercibleValue returns a literal of another scalar type that is converted
+// to typ in the non-strict mode and causes a TypeError in the strict mode.
+// It returns nil if there are no such literals for typ.
+func (g *exprGenerator) coercibleValue(typ ir.Type) *ir.Node {
+	scalar, ok := typ.(*ir.ScalarType)
+	if !ok {
+		return nil
+	}
+	var candidates []*ir.Node
+	switch scalar.Kind {
+	case ir.ScalarBool:
+		candidates = []*ir.Node{ir.NewIntLit(0), ir.NewIntLit(2), ir.NewStringLit(""), ir.NewStringLit("0"), ir.NewStringLit("a")}
+	case ir.ScalarInt:
+		candidates = []*ir.Node{ir.NewBoolLit(true), ir.NewBoolLit(false), ir.NewStringLit("12"), ir.NewStringLit("-3"), ir.NewFloatLit(2)}
+	case ir.ScalarFloat:
+		candidates = []*ir.Node{ir.NewBoolLit(true), ir.NewStringLit("1.5"), ir.NewStringLit("10")}
+	case ir.ScalarString:
+		candidates = []*ir.Node{ir.NewBoolLit(true), ir.NewIntLit(10), ir.NewIntLit(-1), ir.NewFloatLit(1.5)}
+	default:
+		return nil
+	}
+	return randutil.Elem(g.rand, candidates)
 }
 
-func (g *exprGenerator) intCall() *ir.Node {
-	return g.callOfType(g.symtab.intFuncs[g.rand.Intn(len(g.symtab.intFuncs))])
+func (g *exprGenerator) callArgs(fn *ir.FuncType) ([]*ir.Node, bool) {
+	numArgs := randutil.IntRange(g.rand, fn.MinArgsNum, len(fn.Params))
+	callArgs := make([]*ir.Node, numArgs)
+	for i := range callArgs {
+		param := fn.Params[i]
+		if param.Flags.IsRef() {
+			v := g.findRandomVar(func(v *scopeVar) bool {
+				return v.name != "this" && typesIdentical(v.typ, param.Type)
+			})
+			if v == nil {
+				return nil, false
+			}
+			callArgs[i] = ir.NewVar(v.name, v.typ)
+			continue
+		}
+		if param.Flags.IsTypeHinted() && randutil.Chance(g.rand, g.coercibleArgChance()) {
+			if arg := g.coercibleValue(param.Type); arg != nil {
+				callArgs[i] = arg
+				continue
+			}
+		}
+		arg := g.GenerateValueOfType(param.Type)
+		if param.Strict {
+			arg = &ir.Node{Op: ir.OpCast, Args: []*ir.Node{g.maybeAddParens(arg)}, Type: param.Type}
+		}
+		callArgs[i] = arg
+	}
+	return callArgs, true
+}
+
+func (g *exprGenerator) boolCall() *ir.Node   { return g.randomCall(g.symtab.boolFuncs, g.boolLit) }
+func (g *exprGenerator) intCall() *ir.Node    { return g.randomCall(g.symtab.intFuncs, g.intLit) }
+func (g *exprGenerator) floatCall() *ir.Node  { return g.randomCall(g.symtab.floatFuncs, g.floatLit) }
+func (g *exprGenerator) stringCall() *ir.Node { return g.randomCall(g.symtab.stringFuncs, g.stringLit) }
+
+// randomCall uses a fallback if there are no funcs to call,
+// the builtins can be disabled by Config.DisabledBuiltins.
+// Retrying the other choices won't help if the call is the only enabled one.
+func (g *exprGenerator) randomCall(funcs []*ir.FuncType, fallback func() *ir.Node) *ir.Node {
+	if len(funcs) == 0 {
+		return fallback()
+	}
+	return g.callOfType(funcs[g.rand.Intn(len(funcs))])
+}
+
+func (g *exprGenerator) boolClosureCall() *ir.Node   { return g.closureCallOfType(ir.BoolType) }
+func (g *exprGenerator) intClosureCall() *ir.Node    { return g.closureCallOfType(ir.IntType) }
+func (g *exprGenerator) floatClosureCall() *ir.Node  { return g.closureCallOfType(ir.FloatType) }
+func (g *exprGenerator) stringClosureCall() *ir.Node { return g.closureCallOfType(ir.StringType) }
+
+func (g *exprGenerator) closureCallOfType(typ ir.Type) *ir.Node {
+	v := g.findRandomVar(func(v *scopeVar) bool {
+		fn, ok := v.typ.(*ir.FuncType)
+		return ok && typesIdentical(fn.Result, typ)
+	})
+	if v == nil {
+		return nil
+	}
+	g.exprDepth++
+	defer func() { g.exprDepth-- }()
+
+	fn := v.typ.(*ir.FuncType)
+	args, ok := g.callArgs(fn)
+	if !ok {
+		return nil
+	}
+	return ir.NewCall(ir.NewVar(v.name, v.typ), args...)
+}
+
+func (g *exprGenerator) boolNarrowed() *ir.Node   { return g.narrowedValueOfType(ir.BoolType) }
+func (g *exprGenerator) intNarrowed() *ir.Node    { return g.narrowedValueOfType(ir.IntType) }
+func (g *exprGenerator) floatNarrowed() *ir.Node  { return g.narrowedValueOfType(ir.FloatType) }
+func (g *exprGenerator) stringNarrowed() *ir.Node { return g.narrowedValueOfType(ir.StringType) }
+
+// narrowedValueOfType finds a nullable or union-typed variable that
+// can hold a typ value and turns it into a typ expression.
+func (g *exprGenerator) narrowedValueOfType(typ ir.Type) *ir.Node {
+	v := g.findRandomVar(func(v *scopeVar) bool {
+		switch vtyp := v.typ.(type) {
+		case *ir.NullableType:
+			return typesIdentical(vtyp.X, typ)
+		case *ir.UnionType:
+			return typesIdentical(vtyp.X, typ) || typesIdentical(vtyp.Y, typ)
+		default:
+			return false
+		}
+	})
+	if v == nil {
+		return nil
+	}
+
+	g.exprDepth++
+	defer func() { g.exprDepth-- }()
+
+	x := ir.NewVar(v.name, v.typ)
+	var result *ir.Node
+	switch vtyp := v.typ.(type) {
+	case *ir.NullableType:
+		switch g.rand.Intn(3) {
+		case 0:
+			result = ir.NewNullCoalesce(x, g.maybeAddParens(g.GenerateValueOfType(typ)))
+		case 1:
+			result = g.newTernary(newSimpleCall("is_null", x), g.GenerateValueOfType(typ), x)
+		default:
+			result = g.newTernary(ir.NewNotEqual3(x, ir.NewName("null")), x, g.GenerateValueOfType(typ))
+		}
+	case *ir.UnionType:
+		checkedType := vtyp.X
+		if !typesIdentical(checkedType, typ) {
+			checkedType = vtyp.Y
+		}
+		result = g.newTernary(g.typeCheckOf(x, checkedType), x, g.GenerateValueOfType(typ))
+	}
+	if _, ok := typ.(*ir.ScalarType); ok {
+		result = &ir.Node{Op: ir.OpCast, Args: []*ir.Node{g.maybeAddParens(result)}, Type: typ}
+	}
+	return result
 }
 
-func (g *exprGenerator) floatCall() *ir.Node {
-	return g.callOfType(g.symtab.floatFuncs[g.rand.Intn(len(g.symtab.floatFuncs))])
+// typeCheck generates a run time type check for a variable.
+func (g *exprGenerator) typeCheck() *ir.Node {
+	v := g.findRandomVar(func(v *scopeVar) bool {
+		switch v.typ.(type) {
+		case *ir.NullableType, *ir.UnionType, *ir.ClassType:
+			return true
+		default:
+			return false
+		}
+	})
+	if v == nil {
+		return nil
+	}
+	x := ir.NewVar(v.name, v.typ)
+	switch vtyp := v.typ.(type) {
+	case *ir.NullableType:
+		if randutil.Bool(g.rand) {
+			return newSimpleCall("is_null", x)
+		}
+		return g.typeCheckOf(x, vtyp.X)
+	case *ir.UnionType:
+		if randutil.Bool(g.rand) {
+			return g.typeCheckOf(x, vtyp.X)
+		}
+		return g.typeCheckOf(x, vtyp.Y)
+	case *ir.ClassType:
+		return g.typeCheckOf(x, randutil.Elem(g.rand, g.symtab.RelatedClasses(vtyp)))
+	default:
+		return nil
+	}
 }
 
-func (g *exprGenerator) stringCall() *ir.Node {
-	return g.callOfType(g.symtab.stringFuncs[g.rand.Intn(len(g.symtab.stringFuncs))])
+func (g *exprGenerator) typeCheckOf(x *ir.Node, typ ir.Type) *ir.Node {
+	switch typ := typ.(type) {
+	case *ir.ClassType:
+		return ir.NewParens(ir.NewInstanceof(x, typ.Name))
+	case *ir.ArrayType:
+		return newSimpleCall("is_array", x)
+	case *ir.ScalarType:
+		return newSimpleCall("is_"+typ.Kind.String(), x)
+	default:
+		panic(fmt.Sprintf("can't generate a type check for %s", typ))
+	}
 }
 
 func (g *exprGenerator) maybeAddParens(n *ir.Node) *ir.Node {
@@ -561,14 +933,69 @@ func (g *exprGenerator) intCast() *ir.Node    { return g.castToType(ir.IntType)
 func (g *exprGenerator) stringCast() *ir.Node { return g.castToType(ir.StringType) }
 
 func (g *exprGenerator) classValue(typ *ir.ClassType) *ir.Node {
+	if randutil.Chance(g.rand, 0.2) {
+		if n := g.narrowedValueOfType(typ); n != nil {
+			return n
+		}
+	}
+
 	g.exprDepth++
 	defer func() { g.exprDepth-- }()
 
-	return &ir.Node{
-		Op:    ir.OpNew,
-		Value: typ.Name,
-		Type:  typ,
+	// Base class typed values can hold any of the subclasses instances.
+	class := typ
+	if typ.Flags.IsAbstract() || typ.Flags.IsInterface() || randutil.Chance(g.rand, 0.3) {
+		if subclasses := g.symtab.ConcreteSubclasses(typ); len(subclasses) != 0 {
+			class = randutil.Elem(g.rand, subclasses)
+		}
+	}
+
+	var args []*ir.Node
+	if ctor := class.FindConstructor(); ctor != nil {
+		ok := false
+		if g.exprDepth < 10 {
+			args, ok = g.callArgs(ctor)
+		}
+		if !ok {
+			// Deeply nested objects are created with constant arguments,
+			// otherwise the expression size can grow exponentially.
+			args = make([]*ir.Node, len(ctor.Params))
+			for i, p := range ctor.Params {
+				args[i] = g.GenerateConstValueOfType(p.Type)
+			}
+		}
+	}
+	return newObject(class, args)
+}
+
+// objectValue returns an expression that evaluates to a c instance.
+// Sometimes it's a member access chain over the constructor-initialized fields.
+func (g *exprGenerator) objectValue(c *ir.ClassType) *ir.Node {
+	if g.exprDepth < 8 && randutil.Chance(g.rand, 0.3) {
+		if n := g.objectFieldChain(c); n != nil {
+			return n
+		}
 	}
+	return g.maybeAddParens(g.GenerateValueOfType(c))
+}
+
+func (g *exprGenerator) objectFieldChain(c *ir.ClassType) *ir.Node {
+	var candidates []fieldRef
+	for _, ref := range g.symtab.objectFields {
+		field := ref.Get()
+		fieldClass := field.Type.(*ir.ClassType)
+		if (fieldClass == c || fieldClass.IsSubclassOf(c)) && g.canAccess(field.Flags, ref.class) {
+			candidates = append(candidates, ref)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	ref := randutil.Elem(g.rand, candidates)
+
+	g.exprDepth++
+	defer func() { g.exprDepth-- }()
+	return ir.NewMemberAccess(g.objectValue(ref.class), ref.Get().Name)
 }
 
 func (g *exprGenerator) constTupleValue(typ *ir.TupleType) *ir.Node {
@@ -591,21 +1018,155 @@ func (g *exprGenerator) makeTupleValue(typ *ir.TupleType, elemGen func(ir.Type)
 	return ir.NewCall(ir.NewName("tuple"), elems...)
 }
 
-func (g *exprGenerator) constArrayValue(elemType ir.Type) *ir.Node {
-	return g.makeArrayValue(elemType, g.GenerateConstValueOfType)
+func (g *exprGenerator) constArrayValue(typ *ir.ArrayType) *ir.Node {
+	return g.makeArrayValue(typ, g.GenerateConstValueOfType)
 }
 
 func (g *exprGenerator) arrayValue(typ *ir.ArrayType) *ir.Node {
-	if randutil.Chance(g.rand, 0.3) {
+	if g.exprDepth < 10 && randutil.Chance(g.rand, 0.3) {
 		funcs := g.symtab.FindFuncsOfType(typ)
 		if len(funcs) != 0 {
-			return g.callOfType(randutil.Elem(g.rand, funcs))
+			if call := g.callOfType(randutil.Elem(g.rand, funcs)); call != nil {
+				return call
+			}
+		}
+	}
+	if _, ok := typ.Elem.(*ir.ScalarType); ok && g.exprDepth < 10 && randutil.Chance(g.rand, 0.1) {
+		if randutil.Bool(g.rand) {
+			return g.arrayMap(typ)
 		}
+		return g.arrayFilter(typ)
 	}
-	return g.makeArrayValue(typ.Elem, g.GenerateValueOfType)
+	return g.makeArrayValue(typ, g.GenerateValueOfType)
 }
 
-func (g *exprGenerator) makeArrayValue(elemType ir.Type, elemGen func(ir.Type) *ir.Node) *ir.Node {
+func (g *exprGenerator) arrayMap(typ *ir.ArrayType) *ir.Node {
+	srcType := &ir.ArrayType{Key: typ.Key, Elem: g.PickScalarType()}
+	fn := &ir.FuncType{
+		Params:     []ir.TypeField{{Type: srcType.Elem}},
+		MinArgsNum: 1,
+		Result:     typ.Elem,
+	}
+	return newSimpleCall("array_map", g.closureValue(fn), g.arrayValue(srcType))
+}
+
+func (g *exprGenerator) arrayFilter(typ *ir.ArrayType) *ir.Node {
+	fn := &ir.FuncType{
+		Params:     []ir.TypeField{{Type: typ.Elem}},
+		MinArgsNum: 1,
+		Result:     ir.BoolType,
+	}
+	return newSimpleCall("array_filter", g.arrayValue(typ), g.closureValue(fn))
+}
+
+// closureParams returns a copy of typ with unique param names.
+// Unique names make it impossible to clash with the captured variables.
+func (g *exprGenerator) closureParams(typ *ir.FuncType) *ir.FuncType {
+	fn := *typ
+	fn.Params = make([]ir.TypeField, len(typ.Params))
+	for i, p := range typ.Params {
+		p.Name = "a" + strconv.Itoa(g.closureParamSeq)
+		g.closureParamSeq++
+		fn.Params[i] = p
+	}
+	g.markTypeHints(&fn)
+	return &fn
+}
+
+// markTypeHints sets or clears the native type hints of the fn closure.
+func (g *exprGenerator) markTypeHints(fn *ir.FuncType) {
+	fn.Flags &^= ir.FlagTypeHinted
+	if g.nativeHints {
+		fn.Flags |= ir.FlagTypeHinted
+	}
+	for i := range fn.Params {
+		fn.Params[i].Flags &^= ir.FlagTypeHinted
+		if g.nativeHints {
+			fn.Params[i].Flags |= ir.FlagTypeHinted
+		}
+	}
+}
+
+func (g *exprGenerator) closureValue(typ *ir.FuncType) *ir.Node {
+	g.exprDepth++
+	defer func() { g.exprDepth-- }()
+
+	fn := g.closureParams(typ)
+	if randutil.Bool(g.rand) {
+		return g.arrowFunc(fn)
+	}
+	return g.closure(fn)
+}
+
+func (g *exprGenerator) arrowFunc(fn *ir.FuncType) *ir.Node {
+	// Arrow functions capture the entire parent scope by value.
+	g.scope.Enter()
+	defer g.scope.Leave()
+	for _, p := range fn.Params {
+		g.scope.PushParam(p.Name, p.Type)
+	}
+	return ir.NewArrowFunc(fn, g.GenerateValueOfType(fn.Result))
+}
+
+func (g *exprGenerator) closure(fn *ir.FuncType) *ir.Node {
+	var uses []*ir.Node
+	var refs []*scopeVar
+	numUses := randutil.IntRange(g.rand, 0, 3)
+	for i := 0; i < numUses; i++ {
+		v := g.findRandomVar(func(v *scopeVar) bool {
+			if v.name == "this" {
+				return false
+			}
+			for _, u := range uses {
+				if u.Op == ir.OpRef {
+					u = u.Args[0]
+				}
+				if u.Value.(string) == v.name {
+					return false
+				}
+			}
+			return true
+		})
+		if v == nil {
+			break
+		}
+		use := ir.NewVar(v.name, v.typ)
+		if g.config.PHPOnly && randutil.Chance(g.rand, 0.3) {
+			use = ir.NewRef(use)
+			refs = append(refs, v)
+		}
+		uses = append(uses, use)
+	}
+
+	// Only the captured variables and params are visible inside the closure body.
+	captured := make([]scopeVar, 0, len(uses))
+	for _, u := range uses {
+		if u.Op == ir.OpRef {
+			u = u.Args[0]
+		}
+		captured = append(captured, scopeVar{name: u.Value.(string), typ: u.Type})
+	}
+	g.scope.EnterFunc()
+	defer g.scope.LeaveFunc()
+	for _, v := range captured {
+		g.scope.PushVar(v.name, v.typ)
+	}
+	for _, p := range fn.Params {
+		g.scope.PushParam(p.Name, p.Type)
+	}
+
+	body := ir.NewBlock()
+	for _, v := range refs {
+		// Modify the variables captured by reference,
+		// so the effect can be observed outside of the closure.
+		lhs := ir.NewVar(v.name, v.typ)
+		body.Args = append(body.Args, ir.NewAssign(lhs, g.GenerateValueOfType(v.typ)))
+	}
+	body.Args = append(body.Args, ir.NewReturn(g.GenerateValueOfType(fn.Result)))
+	return ir.NewClosure(fn, body, uses...)
+}
+
+func (g *exprGenerator) makeArrayValue(typ *ir.ArrayType, elemGen func(ir.Type) *ir.Node) *ir.Node {
 	g.exprDepth++
 	defer func() { g.exprDepth-- }()
 
@@ -616,11 +1177,34 @@ func (g *exprGenerator) makeArrayValue(elemType ir.Type, elemGen func(ir.Type) *
 	numElems := randutil.IntRange(g.rand, 1, maxNumElems)
 	elems := make([]*ir.Node, numElems)
 	for i := 0; i < numElems; i++ {
-		elems[i] = elemGen(elemType)
+		elems[i] = elemGen(typ.Elem)
+		if typ.Key != nil {
+			elems[i] = ir.NewKeyValue(g.arrayKey(typ.Key), elems[i])
+		}
 	}
 	return &ir.Node{Op: ir.OpArrayLit, Args: elems}
 }
 
+// arrayKey returns a constant key of the specified type.
+// The int-like strings are only used for the mixed keys as they're converted to ints.
+func (g *exprGenerator) arrayKey(keyType ir.Type) *ir.Node {
+	switch keyType.(*ir.ScalarType).Kind {
+	case ir.ScalarInt:
+		return ir.NewIntLit(g.valueGenerator.IntKeyValue())
+	case ir.ScalarString:
+		return ir.NewStringLit(g.valueGenerator.StringKeyValue())
+	default:
+		switch g.rand.Intn(3) {
+		case 0:
+			return ir.NewIntLit(g.valueGenerator.IntKeyValue())
+		case 1:
+			return ir.NewStringLit(g.valueGenerator.StringKeyValue())
+		default:
+			return ir.NewStringLit(randutil.Elem(g.rand, intStringKeyValues))
+		}
+	}
+}
+
 func (g *exprGenerator) findRandomVar(predicate func(v *scopeVar) bool) *scopeVar {
 	g.scratch = g.scratch[:0]
 	g.scope.FindVar(func(v *scopeVar) bool {
@@ -645,12 +1229,27 @@ func (g *exprGenerator) PickLvalue() (*ir.Node, ir.Type) {
 			return ok
 		})
 		if classVar != nil {
-			classType := classVar.typ.(*ir.ClassType)
-			if len(classType.Fields) != 0 {
-				field := randutil.Elem(g.rand, classType.Fields)
+			var fields []*ir.TypeField
+			for c := classVar.typ.(*ir.ClassType); c != nil; c = c.Parent {
+				for i := range c.Fields {
+					field := &c.Fields[i]
+					if !field.Flags.IsStatic() && g.canAccess(field.Flags, c) {
+						fields = append(fields, field)
+					}
+				}
+			}
+			if len(fields) != 0 {
+				field := randutil.Elem(g.rand, fields)
 				return ir.NewMemberAccess(ir.NewVar(classVar.name, classVar.typ), field.Name), field.Type
 			}
 		}
+	} else if roll < 0.5 && len(g.symtab.staticFields) != 0 {
+		ref := randutil.Elem(g.rand, g.symtab.staticFields)
+		field := ref.Get()
+		if g.canAccess(field.Flags, ref.class) {
+			classRef := g.classRef(ref.class, !field.Flags.IsPrivate())
+			return ir.NewStaticMemberAccess(classRef, "$"+field.Name), field.Type
+		}
 	}
 
 	blockVars := g.scope.CurrentBlockVars()
@@ -661,6 +1260,24 @@ func (g *exprGenerator) PickLvalue() (*ir.Node, ir.Type) {
 	return ir.NewVar(v.name, v.typ), v.typ
 }
 
+// linkFields returns the owner instance fields that can be assigned with a value of the typ class.
+func (g *exprGenerator) linkFields(owner, typ *ir.ClassType) []*ir.TypeField {
+	var fields []*ir.TypeField
+	for c := owner; c != nil; c = c.Parent {
+		for i := range c.Fields {
+			field := &c.Fields[i]
+			fieldClass, ok := field.Type.(*ir.ClassType)
+			if !ok || field.Flags.IsStatic() || !g.canAccess(field.Flags, c) {
+				continue
+			}
+			if typ == fieldClass || typ.IsSubclassOf(fieldClass) {
+				fields = append(fields, field)
+			}
+		}
+	}
+	return fields
+}
+
 func (g *exprGenerator) lvalueOfType(typ ir.Type) *ir.Node {
 	if v := g.varOfType(typ); v != nil {
 		return v
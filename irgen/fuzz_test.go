@@ -0,0 +1,98 @@
+package irgen
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"runtime/debug"
+	"testing"
+	"time"
+
+	"github.com/quasilyte/phpsmith/ir"
+	"github.com/quasilyte/phpsmith/irprint"
+	"github.com/quasilyte/phpsmith/randutil"
+)
+
+// fuzzTimeout bounds a single program generation and printing,
+// a slower input is reported as a hang.
+const fuzzTimeout = 10 * time.Second
+
+// FuzzCreateProgram turns the fuzzer bytes into the generator choices,
+// see randutil.ChoiceReplayer. Every panic, hang or IR validation error is a crash.
+//
+// Every input generates a complete program, so a short -fuzzminimizetime helps:
+//
+//	go test ./irgen -run - -fuzz FuzzCreateProgram -fuzzminimizetime 5s
+func FuzzCreateProgram(f *testing.F) {
+	f.Add([]byte{}, false)
+	f.Add([]byte{1, 2, 3, 4, 5, 6, 7, 8}, true)
+	for seed := int64(1); seed <= 3; seed++ {
+		data := make([]byte, 256)
+		rand.New(rand.NewSource(seed)).Read(data)
+		f.Add(data, seed%2 == 0)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte, php8 bool) {
+		// The failures are reported from the test goroutine, so the fuzz
+		// engine records the input; the stuck generation is abandoned.
+		done := make(chan []*ir.ValidationError, 1)
+		panicked := make(chan string, 1)
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					panicked <- fmt.Sprintf("%v\n%s", r, debug.Stack())
+				}
+			}()
+			done <- createAndPrintProgram(data, php8)
+		}()
+		select {
+		case errors := <-done:
+			for _, err := range errors {
+				t.Fatal(err)
+			}
+		case msg := <-panicked:
+			t.Fatalf("panic: %s", msg)
+		case <-time.After(fuzzTimeout):
+			t.Fatalf("program generation takes longer than %v", fuzzTimeout)
+		}
+	})
+}
+
+// createAndPrintProgram generates a program from the fuzzer input and prints it.
+// It returns the program IR validation errors, if any.
+func createAndPrintProgram(data []byte, php8 bool) []*ir.ValidationError {
+	replayer := randutil.NewChoiceReplayer(bytesToChoices(data))
+	program := CreateProgram(&Config{
+		Rand:    replayer.Rand(),
+		SubRand: replayer.SubRand,
+		PHP8:    php8,
+	})
+	if errors := ir.Validate(program.Files); len(errors) != 0 {
+		return errors
+	}
+
+	printerConfig := &irprint.Config{
+		Rand:              rand.New(rand.NewSource(int64(len(data)))),
+		AlternativeSyntax: len(data)%2 == 0,
+		ExoticLiterals:    len(data)%3 == 0,
+	}
+	var buf bytes.Buffer
+	for _, f := range program.Files {
+		irprint.FprintFile(&buf, f.Name, f.Nodes, printerConfig)
+	}
+	return nil
+}
+
+// bytesToChoices splits data into the 8-byte choices,
+// the last incomplete choice is zero-padded.
+func bytesToChoices(data []byte) []uint64 {
+	choices := make([]uint64, 0, (len(data)+7)/8)
+	for len(data) != 0 {
+		var buf [8]byte
+		n := copy(buf[:], data)
+		choices = append(choices, binary.LittleEndian.Uint64(buf[:]))
+		data = data[n:]
+	}
+	return choices
+}
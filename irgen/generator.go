@@ -16,11 +16,19 @@ import (
 //go:embed _php/fuzzlib.php
 var phpFuzzlib []byte
 
+// phpException is a builtin base class for all exceptions.
+var phpException = &ir.ClassType{Name: "Exception"}
+
+var phpTypeError = &ir.ClassType{Name: "TypeError"}
+
 type generator struct {
 	config *Config
 
 	rand *rand.Rand
 
+	// seed is a root seed for the random sub-streams.
+	seed int64
+
 	files []*File
 
 	stmtDepth int
@@ -35,6 +43,18 @@ type generator struct {
 
 	symtab *symbolTable
 	expr   *exprGenerator
+
+	constructors map[*ir.ClassType]*ir.RootFuncDecl
+
+	// generatorFuncs are the functions that return a generator, in declaration order.
+	generatorFuncs []*ir.FuncType
+
+	// currentGenerator is a generator function being generated, if any.
+	currentGenerator *ir.FuncType
+
+	disabledStmts map[string]bool
+
+	metadata Metadata
 }
 
 type fileTemplate struct {
@@ -46,23 +66,38 @@ type fileTemplate struct {
 func newGenerator(config *Config) *generator {
 	symtab := newSymbolTable()
 	{
+		disabled := make(map[string]bool, len(config.DisabledBuiltins))
+		for _, name := range config.DisabledBuiltins {
+			disabled[name] = true
+		}
 		coreFuncs := phpfunc.GetList()
 		for _, fn := range coreFuncs {
-			symtab.AddFunc(fn)
+			if !disabled[fn.Name] {
+				symtab.AddFunc(fn)
+			}
 		}
 	}
 
+	disabledStmts := make(map[string]bool, len(config.DisabledStmts))
+	for _, kind := range config.DisabledStmts {
+		disabledStmts[kind] = true
+	}
+
 	s := newScope()
 	return &generator{
-		config: config,
-		rand:   config.Rand,
-		symtab: symtab,
-		scope:  s,
-		expr:   newExprGenerator(config, s, symtab),
+		config:        config,
+		rand:          config.Rand,
+		symtab:        symtab,
+		scope:         s,
+		expr:          newExprGenerator(config, s, symtab),
+		constructors:  make(map[*ir.ClassType]*ir.RootFuncDecl),
+		disabledStmts: disabledStmts,
 	}
 }
 
 func (g *generator) CreateProgram() *Program {
+	g.seed = g.rand.Int63()
+
 	var mainFileRequires []*ir.RootRequire
 
 	mainFileRequires = append(mainFileRequires, &ir.RootRequire{Path: "fuzzlib.php"})
@@ -74,17 +109,41 @@ func (g *generator) CreateProgram() *Program {
 	// This is needed to finalize the types information.
 	var fileTemplates []fileTemplate
 
-	numClasses := randutil.IntRange(g.rand, 7, 10)
 	// First, declare all the classes without setting their fields or methods.
+	numInterfaces := randutil.IntRange(g.rand, 1, 3)
+	for i := 0; i < numInterfaces; i++ {
+		iface := g.symtab.DeclareClass(fmt.Sprintf("Interface%d", i))
+		iface.Flags |= ir.FlagInterface
+	}
+	numClasses := randutil.IntRange(g.rand, 7, 10)
 	for i := 0; i < numClasses; i++ {
 		className := fmt.Sprintf("Class%d", i)
 		g.symtab.DeclareClass(className)
 	}
 	// Now that all classes can reference each other, generate their types.
 	// We have class types available for the fields at this point.
-	for _, c := range g.symtab.classes {
+	// Classes are created in the declaration order, so the parent classes
+	// and interfaces are complete by the time their subclasses are created.
+	for _, c := range g.symtab.classList {
 		fileName := c.Name + ".php"
-		fileTemplates = append(fileTemplates, g.createClassFileTemplate(c.Name, fileName))
+		fileTemplates = append(fileTemplates, g.createClassFileTemplate(c, fileName))
+	}
+	func() {
+		defer g.enterSubStream("class hierarchy")()
+		g.finalizeClassHierarchy()
+	}()
+
+	numExceptions := randutil.IntRange(g.rand, 2, 4)
+	for i := 0; i < numExceptions; i++ {
+		parent := phpException
+		if len(g.symtab.exceptions) != 0 && randutil.Bool(g.rand) {
+			parent = randutil.Elem(g.rand, g.symtab.exceptions)
+		}
+		c := g.symtab.DeclareException(fmt.Sprintf("Exception%d", i), parent)
+		fileTemplates = append(fileTemplates, fileTemplate{
+			name:       c.Name + ".php",
+			classTypes: []*ir.ClassType{c},
+		})
 	}
 
 	numLibs := randutil.IntRange(g.rand, 3, 5)
@@ -94,10 +153,8 @@ func (g *generator) CreateProgram() *Program {
 	}
 
 	// Define/add all symbols.
+	// Classes are defined during their creation.
 	for _, ft := range fileTemplates {
-		for _, c := range ft.classTypes {
-			g.symtab.DefineClass(c)
-		}
 		for _, f := range ft.funcTypes {
 			g.symtab.AddFunc(f)
 		}
@@ -107,26 +164,135 @@ func (g *generator) CreateProgram() *Program {
 	// No new symbols should be added after this point.
 	g.symtab.Sort()
 
+	g.metadata.Typing = TypingStyle(g.rand.Intn(4))
+	g.metadata.ExprWeights = g.config.ExprWeights
+	g.metadata.DisabledStmts = g.config.DisabledStmts
+	g.metadata.DisabledBuiltins = g.config.DisabledBuiltins
+	g.applyTypingStyle(fileTemplates)
+
+	// The strict mode affects the calls that are made from the file,
+	// not the declarations it contains. It only makes a difference
+	// for the native type hints, so the files are generated knowing
+	// whether the coercible call args would throw a TypeError.
+	strictFiles := make(map[string]bool)
+	if g.metadata.Typing.NativeHints() {
+		pickStrict := func() bool { return randutil.Chance(g.rand, 0.2) }
+		if g.config.SingleFile {
+			allStrict := pickStrict()
+			pickStrict = func() bool { return allStrict }
+		}
+		for _, ft := range fileTemplates {
+			strictFiles[ft.name] = pickStrict()
+		}
+		strictFiles["main.php"] = pickStrict()
+	}
+
 	// Next generate the actual IR for the file templates.
 	for _, ft := range fileTemplates {
+		g.expr.strictTypes = strictFiles[ft.name]
 		g.files = append(g.files, g.createFile(ft))
 		mainFileRequires = append(mainFileRequires, &ir.RootRequire{Path: ft.name})
 	}
 
+	g.expr.strictTypes = strictFiles["main.php"]
 	mainFile := g.createMainFile(mainFileRequires)
 	g.files = append(g.files, mainFile)
+
+	for _, f := range g.files {
+		if !strictFiles[f.Name] {
+			continue
+		}
+		strictTypes := &ir.RootDeclare{Directive: "strict_types", Value: ir.NewIntLit(1)}
+		f.Nodes = append([]ir.RootNode{strictTypes}, f.Nodes...)
+		g.metadata.StrictTypesFiles = append(g.metadata.StrictTypesFiles, f.Name)
+	}
+
 	return &Program{
 		Files:        g.files,
 		RuntimeFiles: runtimeFiles,
+		Metadata:     g.metadata,
+		ExprChoices:  g.expr.choiceOps,
 	}
 }
 
-func (g *generator) createClassFileTemplate(className, fileName string) fileTemplate {
+// applyTypingStyle marks the declarations that should be printed with native types.
+func (g *generator) applyTypingStyle(fileTemplates []fileTemplate) {
+	style := g.metadata.Typing
+	g.expr.nativeHints = style.NativeHints()
+	markFunc := func(fn *ir.FuncType) {
+		if !style.NativeHints() {
+			return
+		}
+		fn.Flags |= ir.FlagTypeHinted
+		for i := range fn.Params {
+			fn.Params[i].Flags |= ir.FlagTypeHinted
+		}
+	}
+
+	for _, c := range g.symtab.classList {
+		for _, m := range c.Methods {
+			markFunc(m)
+		}
+		if ctor := c.Constructor; ctor != nil {
+			markFunc(ctor)
+			for i := range ctor.Params {
+				if ctor.Params[i].Flags.IsPromoted() && style.TypedProperties() {
+					ctor.Params[i].Flags |= ir.FlagTypeHinted
+				}
+			}
+		}
+		if !style.TypedProperties() {
+			continue
+		}
+		// The typed properties without a default value are uninitialized
+		// rather than null, so only the initialized fields are typed.
+		for i := range c.Fields {
+			field := &c.Fields[i]
+			if field.Init != nil || field.Flags.IsPromoted() {
+				field.Flags |= ir.FlagTypeHinted
+			}
+		}
+	}
+	for _, ft := range fileTemplates {
+		for _, fn := range ft.funcTypes {
+			markFunc(fn)
+		}
+	}
+}
+
+// enterSubStream makes the generator draw from the named random sub-stream,
+// so the changes in the other parts of the program don't affect it.
+// The returned func restores the previous random source.
+func (g *generator) enterSubStream(name string) (leave func()) {
+	prev := g.rand
+	if g.config.SubRand != nil {
+		g.setRand(g.config.SubRand(g.seed, name))
+	} else {
+		g.setRand(randutil.SubRand(g.seed, name))
+	}
+	return func() {
+		g.setRand(prev)
+	}
+}
+
+func (g *generator) setRand(r *rand.Rand) {
+	g.rand = r
+	g.expr.rand = r
+	g.expr.valueGenerator.rand = r
+}
+
+func (g *generator) createClassFileTemplate(c *ir.ClassType, fileName string) fileTemplate {
+	defer g.enterSubStream("class " + c.Name)()
+
+	if c.Flags.IsInterface() {
+		g.createInterfaceType(c)
+	} else {
+		g.createClassType(c)
+	}
+	g.symtab.DefineClass(c)
 	return fileTemplate{
-		name: fileName,
-		classTypes: []*ir.ClassType{
-			g.createClassType(className),
-		},
+		name:       fileName,
+		classTypes: []*ir.ClassType{c},
 	}
 }
 
@@ -137,7 +303,14 @@ func (g *generator) createFile(ft fileTemplate) *File {
 		decl := &ir.RootClassDecl{
 			Type: c,
 		}
+		if ctor := g.constructors[c]; ctor != nil {
+			decl.Methods = append(decl.Methods, ctor)
+		}
 		for _, m := range c.Methods {
+			if c.Flags.IsInterface() || m.Flags.IsAbstract() {
+				decl.Methods = append(decl.Methods, &ir.RootFuncDecl{Type: m})
+				continue
+			}
 			decl.Methods = append(decl.Methods, g.createFunc(m))
 		}
 		file.Nodes = append(file.Nodes, decl)
@@ -150,31 +323,302 @@ func (g *generator) createFile(ft fileTemplate) *File {
 	return file
 }
 
-func (g *generator) createClassType(classname string) *ir.ClassType {
-	numFields := randutil.IntRange(g.rand, 3, 8)
-	numMethods := randutil.IntRange(g.rand, 3, 5)
-	c := &ir.ClassType{
-		Name:    classname,
-		Fields:  make([]ir.TypeField, numFields),
-		Methods: make([]*ir.FuncType, numMethods),
+func (g *generator) createInterfaceType(iface *ir.ClassType) {
+	numMethods := randutil.IntRange(g.rand, 1, 2)
+	for i := 0; i < numMethods; i++ {
+		name := fmt.Sprintf("%s_method%d", strings.ToLower(iface.Name), i)
+		fn := g.createFuncType(name, true, iface)
+		fn.Flags |= ir.FlagAbstract
+		iface.Methods = append(iface.Methods, fn)
+		g.symtab.AddFunc(fn)
+	}
+}
+
+func (g *generator) createClassType(c *ir.ClassType) {
+	c.Parent = g.pickParentClass(c)
+	switch {
+	case randutil.Chance(g.rand, 0.2):
+		c.Flags |= ir.FlagAbstract
+	case randutil.Chance(g.rand, 0.15):
+		c.Flags |= ir.FlagFinal
 	}
+
+	// Member names are unique inside the class hierarchy,
+	// so they never clash with the inherited members.
+	fieldOffset := 0
+	constOffset := 0
+	methodOffset := 0
+	for p := c.Parent; p != nil; p = p.Parent {
+		fieldOffset += len(p.Fields)
+		constOffset += len(p.Consts)
+		methodOffset += len(p.Methods)
+	}
+
+	numFields := randutil.IntRange(g.rand, 3, 8)
+	c.Fields = make([]ir.TypeField, numFields)
 	for i := range c.Fields {
 		field := &c.Fields[i]
-		field.Name = fmt.Sprintf("field%d", i)
+		field.Name = fmt.Sprintf("field%d", fieldOffset+i)
 		field.Type = g.expr.PickType()
+		field.Flags = g.pickAccessModifier()
+		if randutil.Chance(g.rand, 0.15) {
+			field.Flags |= ir.FlagStatic
+		}
 		if canConstexprInitialize(field.Type) && randutil.Chance(g.rand, 0.8) {
 			field.Init = g.expr.GenerateConstValueOfType(field.Type)
 		}
 	}
-	for i := range c.Methods {
-		fn := g.createFuncType(fmt.Sprintf("method%d", i), true, c)
-		c.Methods[i] = fn
+
+	numConsts := randutil.IntRange(g.rand, 0, 2)
+	c.Consts = make([]ir.TypeField, numConsts)
+	for i := range c.Consts {
+		constant := &c.Consts[i]
+		constant.Name = fmt.Sprintf("CONST%d", constOffset+i)
+		constant.Type = randutil.Elem(g.rand, []ir.Type{ir.BoolType, ir.IntType, ir.StringType})
+		constant.Init = g.expr.GenerateConstValueOfType(constant.Type)
+	}
+
+	g.createConstructor(c)
+
+	numMethods := randutil.IntRange(g.rand, 3, 5)
+	for i := 0; i < numMethods; i++ {
+		fn := g.createFuncType(fmt.Sprintf("method%d", methodOffset+i), true, c)
+		switch {
+		case c.Flags.IsAbstract() && randutil.Chance(g.rand, 0.3):
+			fn.Flags |= ir.FlagAbstract
+		case randutil.Chance(g.rand, 0.2):
+			fn.Flags |= ir.FlagStatic
+		}
+		c.Methods = append(c.Methods, fn)
 		g.symtab.AddFunc(fn)
 	}
-	return c
+
+	// Concrete classes have to implement all inherited abstract methods.
+	// Other methods are overridden randomly.
+	for _, m := range inheritedMethods(c) {
+		mustOverride := m.Flags.IsAbstract() && !c.Flags.IsAbstract()
+		if m.Flags.IsStatic() || !(mustOverride || randutil.Chance(g.rand, 0.25)) {
+			continue
+		}
+		g.addMethodOverride(c, m)
+	}
+
+	if randutil.Chance(g.rand, 0.3) {
+		var ifaces []*ir.ClassType
+		for _, other := range g.symtab.classList {
+			if other.Flags.IsInterface() && !c.IsSubclassOf(other) {
+				ifaces = append(ifaces, other)
+			}
+		}
+		if len(ifaces) != 0 {
+			g.implementInterface(c, randutil.Elem(g.rand, ifaces))
+		}
+	}
+}
+
+// createConstructor declares a c constructor and generates its body.
+// Constructors only use their params and constant values, and they only
+// instantiate the classes declared before c, so creating an object
+// never leads to an unbounded recursion.
+func (g *generator) createConstructor(c *ir.ClassType) {
+	if randutil.Chance(g.rand, 0.3) {
+		return
+	}
+
+	ctor := &ir.FuncType{
+		Name:   "__construct",
+		Flags:  ir.FlagPublic,
+		Result: ir.VoidType,
+		Class:  c,
+	}
+	this := ir.NewVar("this", c)
+	var assignments []*ir.Node
+	for i := range c.Fields {
+		field := &c.Fields[i]
+		if field.Flags.IsStatic() || containsClassType(field.Type) || !randutil.Chance(g.rand, 0.6) {
+			continue
+		}
+		param := ir.TypeField{Name: field.Name, Type: field.Type}
+		if g.config.PHP8 && field.Init == nil && randutil.Bool(g.rand) {
+			field.Flags |= ir.FlagPromoted
+			param.Flags = field.Flags
+		} else {
+			assign := ir.NewAssign(ir.NewMemberAccess(this, field.Name), ir.NewVar(param.Name, param.Type))
+			assignments = append(assignments, assign)
+		}
+		ctor.Params = append(ctor.Params, param)
+		ctor.Tags = append(ctor.Tags, &phpdoc.ParamTag{
+			VarName: "$" + param.Name,
+			Type:    param.Type.String(),
+		})
+	}
+	ctor.MinArgsNum = len(ctor.Params)
+
+	// Class-typed fields are initialized with objects, so the member access
+	// chains over these fields never hit a null value.
+	for i := range c.Fields {
+		field := &c.Fields[i]
+		fieldClass, ok := field.Type.(*ir.ClassType)
+		if !ok || field.Flags.IsStatic() || !randutil.Chance(g.rand, 0.8) {
+			continue
+		}
+		candidates := g.constructibleClasses(c, fieldClass)
+		if len(candidates) == 0 {
+			continue
+		}
+		obj := g.constNewObject(randutil.Elem(g.rand, candidates))
+		assignments = append(assignments, ir.NewAssign(ir.NewMemberAccess(this, field.Name), obj))
+		g.symtab.objectFields = append(g.symtab.objectFields, fieldRef{index: i, class: c})
+	}
+
+	body := ir.NewBlock()
+	if c.Parent != nil {
+		if parentCtor := c.Parent.FindConstructor(); parentCtor != nil {
+			args := make([]*ir.Node, len(parentCtor.Params))
+			for i, p := range parentCtor.Params {
+				args[i] = g.forwardedArg(ctor, p.Type)
+			}
+			parentCall := ir.NewCall(ir.NewStaticMemberAccess(ir.NewName("parent"), ctor.Name), args...)
+			body.Args = append(body.Args, parentCall)
+		}
+	}
+	body.Args = append(body.Args, assignments...)
+
+	c.Constructor = ctor
+	g.constructors[c] = &ir.RootFuncDecl{Type: ctor, Body: body}
+}
+
+// forwardedArg returns one of the fn params of the specified type
+// or a constant value if there is no such param.
+func (g *generator) forwardedArg(fn *ir.FuncType, typ ir.Type) *ir.Node {
+	for _, p := range fn.Params {
+		if typesIdentical(p.Type, typ) && randutil.Bool(g.rand) {
+			return ir.NewVar(p.Name, p.Type)
+		}
+	}
+	return g.expr.GenerateConstValueOfType(typ)
+}
+
+// constNewObject returns a c instantiation expression that uses only constant arguments.
+func (g *generator) constNewObject(c *ir.ClassType) *ir.Node {
+	var args []*ir.Node
+	if ctor := c.FindConstructor(); ctor != nil {
+		args = make([]*ir.Node, len(ctor.Params))
+		for i, p := range ctor.Params {
+			args[i] = g.expr.GenerateConstValueOfType(p.Type)
+		}
+	}
+	return newObject(c, args)
+}
+
+// constructibleClasses returns the concrete classes declared before c
+// that can be used as a value of the typ type.
+func (g *generator) constructibleClasses(c, typ *ir.ClassType) []*ir.ClassType {
+	var result []*ir.ClassType
+	for _, other := range g.symtab.classList {
+		if other == c {
+			break
+		}
+		if other.Flags.IsAbstract() || other.Flags.IsInterface() {
+			continue
+		}
+		if other == typ || other.IsSubclassOf(typ) {
+			result = append(result, other)
+		}
+	}
+	return result
+}
+
+func (g *generator) pickParentClass(c *ir.ClassType) *ir.ClassType {
+	if randutil.Bool(g.rand) {
+		return nil
+	}
+	var candidates []*ir.ClassType
+	for _, other := range g.symtab.classList {
+		if other == c {
+			break
+		}
+		if other.Flags.IsInterface() || other.Flags.IsFinal() {
+			continue
+		}
+		candidates = append(candidates, other)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	return randutil.Elem(g.rand, candidates)
+}
+
+func (g *generator) pickAccessModifier() ir.TypeFlags {
+	switch g.rand.Intn(5) {
+	case 0:
+		return ir.FlagPrivate
+	case 1:
+		return ir.FlagProtected
+	default:
+		return ir.FlagPublic
+	}
+}
+
+func (g *generator) addMethodOverride(c *ir.ClassType, m *ir.FuncType) {
+	fn := *m
+	fn.Class = c
+	fn.Flags &^= ir.FlagAbstract
+	c.Methods = append(c.Methods, &fn)
+	g.symtab.AddFunc(&fn)
+}
+
+func (g *generator) implementInterface(c, iface *ir.ClassType) {
+	c.Interfaces = append(c.Interfaces, iface)
+	for _, m := range iface.Methods {
+		g.addMethodOverride(c, m)
+	}
+}
+
+// finalizeClassHierarchy makes sure that every abstract class
+// and interface can be instantiated through some concrete class.
+func (g *generator) finalizeClassHierarchy() {
+	for _, c := range g.symtab.classList {
+		if !c.Flags.IsAbstract() || len(g.symtab.ConcreteSubclasses(c)) != 0 {
+			continue
+		}
+		c.Flags &^= ir.FlagAbstract
+		for _, m := range c.Methods {
+			m.Flags &^= ir.FlagAbstract
+		}
+	}
+
+	// The classes that are no longer abstract could inherit the abstract
+	// methods they don't implement. The parents come first in the class list,
+	// so the subclasses see the implementations added to their parents.
+	for _, c := range g.symtab.classList {
+		if c.Flags.IsAbstract() || c.Flags.IsInterface() {
+			continue
+		}
+		for _, m := range inheritedMethods(c) {
+			if c.FindMethod(m.Name).Flags.IsAbstract() {
+				g.addMethodOverride(c, m)
+			}
+		}
+	}
+
+	var concreteClasses []*ir.ClassType
+	for _, c := range g.symtab.classList {
+		if !c.Flags.IsInterface() && !c.Flags.IsAbstract() {
+			concreteClasses = append(concreteClasses, c)
+		}
+	}
+	for _, iface := range g.symtab.classList {
+		if !iface.Flags.IsInterface() || len(g.symtab.ConcreteSubclasses(iface)) != 0 {
+			continue
+		}
+		g.implementInterface(randutil.Elem(g.rand, concreteClasses), iface)
+	}
 }
 
 func (g *generator) createLibFileTemplate(fileName string) fileTemplate {
+	defer g.enterSubStream("file " + fileName)()
+
 	ft := fileTemplate{
 		name: fileName,
 	}
@@ -185,10 +629,45 @@ func (g *generator) createLibFileTemplate(fileName string) fileTemplate {
 		funcType := g.createFuncType(funcName, true, nil)
 		ft.funcTypes = append(ft.funcTypes, funcType)
 	}
+	numGenerators := randutil.IntRange(g.rand, 0, 2)
+	for i := 0; i < numGenerators; i++ {
+		funcName := fmt.Sprintf("%s_gen%d", funcPrefix, i)
+		funcType := g.createGeneratorFuncType(funcName)
+		ft.funcTypes = append(ft.funcTypes, funcType)
+		g.generatorFuncs = append(g.generatorFuncs, funcType)
+	}
 	return ft
 }
 
+func (g *generator) createGeneratorFuncType(name string) *ir.FuncType {
+	typ := &ir.GeneratorType{
+		Send:   g.expr.PickScalarType(),
+		Return: g.expr.PickScalarType(),
+	}
+	// Sharing the key and value types makes it possible
+	// to delegate to the other generators with yield from.
+	if len(g.generatorFuncs) != 0 && randutil.Chance(g.rand, 0.4) {
+		other := randutil.Elem(g.rand, g.generatorFuncs).Result.(*ir.GeneratorType)
+		typ.Key = other.Key
+		typ.Value = other.Value
+	} else {
+		typ.Key = randutil.Elem(g.rand, []ir.Type{ir.IntType, ir.IntType, ir.StringType})
+		typ.Value = g.expr.PickScalarType()
+	}
+
+	fn := &ir.FuncType{
+		Name:      name,
+		Result:    typ,
+		IsLibFunc: true,
+	}
+	g.addParams(fn, 4)
+	fn.Tags = append(fn.Tags, &phpdoc.ReturnTag{Type: fn.Result.String()})
+	return fn
+}
+
 func (g *generator) createMainFile(requires []*ir.RootRequire) *File {
+	defer g.enterSubStream("file main.php")()
+
 	file := &File{
 		Name: "main.php",
 	}
@@ -211,10 +690,18 @@ func (g *generator) createMainFile(requires []*ir.RootRequire) *File {
 		},
 		Body: &ir.Node{Op: ir.OpBlock},
 	}
+	// Every call is wrapped into a try statement,
+	// so the uncaught exceptions don't stop the program.
+	// The TypeError comes from the coercible args in the strict mode,
+	// its message contains the file paths, so only the class is dumped.
 	for _, fn := range funcs {
 		funcNode := ir.NewName(fn.Type.Name)
 		call := &ir.Node{Op: ir.OpCall, Args: []*ir.Node{funcNode}}
-		mainFunc.Body.Args = append(mainFunc.Body.Args, call)
+		e := ir.NewVar("e", phpException)
+		catch := ir.NewCatch([]string{phpException.Name}, e, ir.NewBlock(g.varDumpCall(describeException(e))))
+		typeError := ir.NewVar("e", phpTypeError)
+		typeErrorCatch := ir.NewCatch([]string{phpTypeError.Name}, typeError, ir.NewBlock(g.varDumpCall(newSimpleCall("get_class", typeError))))
+		mainFunc.Body.Args = append(mainFunc.Body.Args, ir.NewTry(ir.NewBlock(call), catch, typeErrorCatch))
 	}
 
 	for _, fn := range funcs {
@@ -243,17 +730,7 @@ func (g *generator) createFuncType(name string, isLibFunc bool, classType *ir.Cl
 		if classType != nil {
 			maxParams = 6
 		}
-		numParams := randutil.IntRange(g.rand, 0, maxParams)
-		for i := 0; i < numParams; i++ {
-			paramName := fmt.Sprintf("p%d", i)
-			param := ir.TypeField{Name: paramName, Type: g.expr.PickType()}
-			fn.Tags = append(fn.Tags, &phpdoc.ParamTag{
-				VarName: "$" + paramName,
-				Type:    param.Type.String(),
-			})
-			fn.Params = append(fn.Params, param)
-		}
-		fn.MinArgsNum = len(fn.Params)
+		g.addParams(fn, maxParams)
 		fn.Tags = append(fn.Tags, &phpdoc.ReturnTag{Type: fn.Result.String()})
 	} else {
 		fn = &ir.FuncType{
@@ -264,16 +741,36 @@ func (g *generator) createFuncType(name string, isLibFunc bool, classType *ir.Cl
 	return fn
 }
 
+func (g *generator) addParams(fn *ir.FuncType, maxParams int) {
+	numParams := randutil.IntRange(g.rand, 0, maxParams)
+	for i := 0; i < numParams; i++ {
+		paramName := fmt.Sprintf("p%d", i)
+		param := ir.TypeField{Name: paramName, Type: g.expr.PickType()}
+		if randutil.Chance(g.rand, 0.15) {
+			param.Flags |= ir.FlagRef
+		}
+		fn.Tags = append(fn.Tags, &phpdoc.ParamTag{
+			VarName: "$" + paramName,
+			Type:    param.Type.String(),
+		})
+		fn.Params = append(fn.Params, param)
+	}
+	fn.MinArgsNum = len(fn.Params)
+}
+
 func (g *generator) createFunc(funcType *ir.FuncType) *ir.RootFuncDecl {
+	defer g.enterSubStream("func " + funcType.FullName())()
+
 	fn := &ir.RootFuncDecl{
 		Body: ir.NewBlock(),
 		Type: funcType,
 	}
 
 	g.scope.Enter()
-	if funcType.Class != nil {
+	if funcType.Class != nil && !funcType.Flags.IsStatic() {
 		g.scope.PushParam("this", funcType.Class)
 	}
+	g.expr.currentClass = funcType.Class
 	for _, param := range fn.Type.Params {
 		g.scope.PushParam(param.Name, param.Type)
 	}
@@ -286,12 +783,19 @@ func (g *generator) createFunc(funcType *ir.FuncType) *ir.RootFuncDecl {
 		}
 	}()
 
+	// The names only have to be unique inside the function, so they
+	// don't depend on the previously generated functions.
 	g.varNameSeq = 0
+	g.expr.closureParamSeq = 0
 	g.currentBlock = fn.Body
+	g.currentGenerator = nil
+	if _, ok := funcType.Result.(*ir.GeneratorType); ok {
+		g.currentGenerator = funcType
+	}
 
 	if funcType.IsLibFunc {
 		call := ir.NewCall(ir.NewName("_visit_function"), ir.NewStringLit(fn.Type.FullName()))
-		ret := ir.NewReturn(g.expr.GenerateConstValueOfType(fn.Type.Result))
+		ret := ir.NewReturn(g.expr.GenerateConstValueOfType(returnType(funcType)))
 		funcCallGuard := ir.NewIf(ir.NewNot(call), ret)
 		g.currentBlock.Args = append(g.currentBlock.Args, funcCallGuard)
 	}
@@ -316,10 +820,36 @@ func (g *generator) createFunc(funcType *ir.FuncType) *ir.RootFuncDecl {
 	for i := 0; i < numStatements; i++ {
 		g.pushStatement()
 	}
+	if g.currentGenerator != nil {
+		numYields := randutil.IntRange(g.rand, 1, 3)
+		for i := 0; i < numYields; i++ {
+			g.pushYieldStmt()
+		}
+	}
 
 	if funcType.IsLibFunc {
-		ret := ir.NewReturn(g.expr.GenerateValueOfType(fn.Type.Result))
-		g.currentBlock.Args = append(g.currentBlock.Args, ret)
+		// Make the by-reference param changes observable by the caller.
+		for _, param := range funcType.Params {
+			if param.Flags.IsRef() && randutil.Bool(g.rand) {
+				lhs := ir.NewVar(param.Name, param.Type)
+				g.currentBlock.Args = append(g.currentBlock.Args, ir.NewAssign(lhs, g.expr.GenerateValueOfType(param.Type)))
+			}
+		}
+		if randutil.Chance(g.rand, 0.2) {
+			throw := ir.NewIf(g.expr.condValue(), ir.NewBlock(g.newThrow()))
+			g.currentBlock.Args = append(g.currentBlock.Args, throw)
+		}
+		var result *ir.Node
+		if parentCall := g.parentMethodCall(funcType); parentCall != nil && randutil.Bool(g.rand) {
+			result = parentCall
+		} else {
+			result = g.expr.GenerateValueOfType(returnType(funcType))
+		}
+		if randutil.Chance(g.rand, 0.15) {
+			g.pushTryReturn(funcType, result)
+		} else {
+			g.currentBlock.Args = append(g.currentBlock.Args, ir.NewReturn(result))
+		}
 	} else {
 		for _, name := range blockVars {
 			v := g.scope.FindVarByName(name)
@@ -333,6 +863,23 @@ func (g *generator) createFunc(funcType *ir.FuncType) *ir.RootFuncDecl {
 	return fn
 }
 
+// parentMethodCall returns a parent::method() call that forwards all the arguments
+// to the overridden method; it returns nil if there is no method to call.
+func (g *generator) parentMethodCall(fn *ir.FuncType) *ir.Node {
+	if fn.Class == nil || fn.Class.Parent == nil || fn.Flags.IsStatic() {
+		return nil
+	}
+	m := fn.Class.Parent.FindMethod(fn.Name)
+	if m == nil || m.Flags.IsAbstract() {
+		return nil
+	}
+	args := make([]*ir.Node, len(fn.Params))
+	for i, p := range fn.Params {
+		args[i] = ir.NewVar(p.Name, p.Type)
+	}
+	return ir.NewCall(ir.NewStaticMemberAccess(ir.NewName("parent"), fn.Name), args...)
+}
+
 func (g *generator) genVarname(internal bool) string {
 	var varname string
 	if internal {
@@ -355,8 +902,13 @@ func (g *generator) pushVarDecl(name string) {
 		}
 	}
 	assign := ir.NewAssign(lhs, rhs)
-	if scalarType, ok := typ.(*ir.ScalarType); ok && scalarType.Kind == ir.ScalarBool {
-		assign.Value = &phpdoc.VarTag{VarName: "$" + name, Type: "bool"}
+	switch typ := typ.(type) {
+	case *ir.ScalarType:
+		if typ.Kind == ir.ScalarBool {
+			assign.Value = &phpdoc.VarTag{VarName: "$" + name, Type: "bool"}
+		}
+	case *ir.FuncType, *ir.NullableType, *ir.UnionType:
+		assign.Value = &phpdoc.VarTag{VarName: "$" + name, Type: typ.String()}
 	}
 	g.currentBlock.Args = append(g.currentBlock.Args, assign)
 	g.scope.PushVar(name, typ)
@@ -368,31 +920,106 @@ func (g *generator) pushStatement() {
 		g.stmtDepth--
 	}()
 
-	switch randutil.IntRange(g.rand, 0, 10+(g.stmtDepth*2)) {
+	// The deeper statements are less likely to be compound,
+	// but the nesting is bounded anyway: the replayed choices
+	// can pick a block statement at every level.
+	if g.stmtDepth > 10 {
+		g.pushVarDecl(g.genVarname(false))
+		return
+	}
+
+	kind := g.stmtKind(randutil.IntRange(g.rand, 0, 20+(g.stmtDepth*2)))
+	if g.disabledStmts[kind] {
+		kind = "var_decl"
+	}
+
+	switch kind {
+	case "break":
+		g.currentBlock.Args = append(g.currentBlock.Args, ir.NewBreak(0))
+	case "continue":
+		g.currentBlock.Args = append(g.currentBlock.Args, ir.NewContinue(0))
+	case "block":
+		g.pushBlockStmt()
+	case "if":
+		g.pushIfStmt()
+	case "var_dump":
+		if !g.pushVarDump() {
+			g.pushAssignStmt()
+		}
+	case "assign":
+		g.pushAssignStmt()
+	case "loop":
+		g.pushLoopStmt()
+	case "switch":
+		g.pushSwitchStmt()
+	case "sort":
+		if !g.pushSortStmt() {
+			g.pushVarDecl(g.genVarname(false))
+		}
+	case "object_link":
+		if !g.pushObjectLinkStmt() {
+			g.pushVarDecl(g.genVarname(false))
+		}
+	case "try":
+		g.pushTryStmt()
+	case "generator":
+		if g.currentGenerator != nil && randutil.Bool(g.rand) {
+			g.pushYieldStmt()
+		} else if !g.pushGeneratorConsumeStmt() {
+			g.pushVarDecl(g.genVarname(false))
+		}
+	case "ref":
+		if !g.pushRefStmt() {
+			g.pushVarDecl(g.genVarname(false))
+		}
+	case "array":
+		if !g.pushArrayStmt() {
+			g.pushVarDecl(g.genVarname(false))
+		}
+	case "list":
+		g.pushListStmt()
+	default:
+		g.pushVarDecl(g.genVarname(false))
+	}
+}
+
+// stmtKind maps a pushStatement roll to a statement kind, see StmtKinds.
+func (g *generator) stmtKind(roll int) string {
+	switch roll {
 	case 0:
 		if g.insideLoop {
-			g.currentBlock.Args = append(g.currentBlock.Args, ir.NewBreak(0))
-		} else {
-			g.pushBlockStmt()
+			return "break"
 		}
+		return "block"
 	case 1:
 		if g.insideLoop {
-			g.currentBlock.Args = append(g.currentBlock.Args, ir.NewContinue(0))
-		} else {
-			g.pushIfStmt()
+			return "continue"
 		}
+		return "if"
 	case 2, 3, 4:
-		if !g.pushVarDump() {
-			g.pushAssignStmt()
-		}
+		return "var_dump"
 	case 5, 6:
-		g.pushAssignStmt()
+		return "assign"
 	case 7:
-		g.pushLoopStmt()
+		return "loop"
 	case 8:
-		g.pushSwitchStmt()
+		return "switch"
+	case 9:
+		return "sort"
+	case 10:
+		return "object_link"
+	case 11:
+		return "try"
+	case 12:
+		return "generator"
+	case 13:
+		return "ref"
+	case 14:
+		return "array"
+	case 15:
+		return "list"
 	default:
-		g.pushVarDecl(g.genVarname(false))
+		return "var_decl"
 	}
 }
 
@@ -474,6 +1101,651 @@ func (g *generator) pushLoopStmt() {
 	g.currentBlock.Args = append(g.currentBlock.Args, whileNode)
 }
 
+// pushObjectLinkStmt assigns an object to a field of another object.
+// When the field types permit it, the objects are linked in both directions,
+// so a reference cycle is created.
+func (g *generator) pushObjectLinkStmt() bool {
+	x := g.expr.findRandomVar(func(v *scopeVar) bool {
+		_, ok := v.typ.(*ir.ClassType)
+		return ok
+	})
+	if x == nil {
+		return false
+	}
+	xClass := x.typ.(*ir.ClassType)
+	y := g.expr.findRandomVar(func(v *scopeVar) bool {
+		c, ok := v.typ.(*ir.ClassType)
+		return ok && len(g.expr.linkFields(xClass, c)) != 0
+	})
+	if y == nil {
+		return false
+	}
+	yClass := y.typ.(*ir.ClassType)
+
+	xVar := ir.NewVar(x.name, x.typ)
+	yVar := ir.NewVar(y.name, y.typ)
+	xField := randutil.Elem(g.rand, g.expr.linkFields(xClass, yClass))
+	g.currentBlock.Args = append(g.currentBlock.Args, ir.NewAssign(ir.NewMemberAccess(xVar, xField.Name), yVar))
+	if backFields := g.expr.linkFields(yClass, xClass); len(backFields) != 0 {
+		yField := randutil.Elem(g.rand, backFields)
+		g.currentBlock.Args = append(g.currentBlock.Args, ir.NewAssign(ir.NewMemberAccess(yVar, yField.Name), xVar))
+	}
+	return true
+}
+
+// pushTryStmt generates a try statement with one or more catch clauses
+// and an optional finally clause.
+func (g *generator) pushTryStmt() {
+	prevCurrentBlock := g.currentBlock
+
+	body := ir.NewBlock()
+	g.scope.Enter()
+	g.currentBlock = body
+	numStatements := randutil.IntRange(g.rand, 1, 3)
+	for i := 0; i < numStatements; i++ {
+		g.pushStatement()
+	}
+	if randutil.Chance(g.rand, 0.3) {
+		body.Args = append(body.Args, g.newThrow())
+	}
+	g.scope.Leave()
+
+	var clauses []*ir.Node
+	numCatches := randutil.IntRange(g.rand, 1, 3)
+	for i := 0; i < numCatches; i++ {
+		classNames := []string{randutil.Elem(g.rand, g.symtab.exceptions).Name}
+		if randutil.Chance(g.rand, 0.3) {
+			other := randutil.Elem(g.rand, g.symtab.exceptions).Name
+			if other != classNames[0] {
+				classNames = append(classNames, other)
+			}
+		}
+		clauses = append(clauses, g.newCatch(classNames))
+	}
+	if randutil.Chance(g.rand, 0.25) {
+		clauses = append(clauses, g.newCatch([]string{phpException.Name}))
+	}
+	if randutil.Chance(g.rand, 0.3) {
+		clauses = append(clauses, g.newFinally())
+	}
+
+	g.currentBlock = prevCurrentBlock
+	g.currentBlock.Args = append(g.currentBlock.Args, ir.NewTry(body, clauses...))
+}
+
+// newCatch returns a catch clause that prints the caught exception.
+// Sometimes the exception is re-thrown after that.
+func (g *generator) newCatch(classNames []string) *ir.Node {
+	prevCurrentBlock := g.currentBlock
+
+	e := ir.NewVar(g.genVarname(true), phpException)
+	body := ir.NewBlock(g.varDumpCall(describeException(e)))
+	g.scope.Enter()
+	g.currentBlock = body
+	if randutil.Bool(g.rand) {
+		g.pushStatement()
+	}
+	if randutil.Chance(g.rand, 0.2) {
+		body.Args = append(body.Args, ir.NewThrow(e))
+	}
+	g.scope.Leave()
+
+	g.currentBlock = prevCurrentBlock
+	return ir.NewCatch(classNames, e, body)
+}
+
+func (g *generator) newFinally() *ir.Node {
+	prevCurrentBlock := g.currentBlock
+	prevInLoop := g.insideLoop
+
+	// PHP forbids jumping out of a finally block with break or continue.
+	g.insideLoop = false
+	body := ir.NewBlock()
+	g.scope.Enter()
+	g.currentBlock = body
+	g.pushStatement()
+	g.scope.Leave()
+
+	g.insideLoop = prevInLoop
+	g.currentBlock = prevCurrentBlock
+	return ir.NewFinally(body)
+}
+
+// pushTryReturn wraps a return statement into a try statement.
+// The finally clause can override the returned value.
+func (g *generator) pushTryReturn(fn *ir.FuncType, result *ir.Node) {
+	var clauses []*ir.Node
+	if randutil.Bool(g.rand) {
+		e := ir.NewVar(g.genVarname(true), phpException)
+		classNames := []string{randutil.Elem(g.rand, g.symtab.exceptions).Name}
+		body := ir.NewBlock(
+			g.varDumpCall(describeException(e)),
+			ir.NewReturn(g.expr.GenerateValueOfType(returnType(fn))),
+		)
+		clauses = append(clauses, ir.NewCatch(classNames, e, body))
+	}
+	if len(clauses) == 0 || randutil.Bool(g.rand) {
+		body := ir.NewBlock(g.varDumpCall(ir.NewStringLit(fn.FullName() + " finally")))
+		if randutil.Bool(g.rand) {
+			body.Args = append(body.Args, ir.NewReturn(g.expr.GenerateValueOfType(returnType(fn))))
+		}
+		clauses = append(clauses, ir.NewFinally(body))
+	}
+	try := ir.NewTry(ir.NewBlock(ir.NewReturn(result)), clauses...)
+	g.currentBlock.Args = append(g.currentBlock.Args, try)
+}
+
+// newThrow returns a throw statement for one of the generated exceptions.
+func (g *generator) newThrow() *ir.Node {
+	c := randutil.Elem(g.rand, g.symtab.exceptions)
+	message := g.expr.GenerateValueOfType(ir.StringType)
+	return ir.NewThrow(newObject(c, []*ir.Node{message, g.expr.intLit()}))
+}
+
+// pushYieldStmt generates a yield statement for the current generator function.
+// Sometimes the value passed to the Generator::send() is assigned to a new variable.
+func (g *generator) pushYieldStmt() {
+	typ := g.currentGenerator.Result.(*ir.GeneratorType)
+
+	if randutil.Chance(g.rand, 0.25) && g.pushYieldFromStmt() {
+		return
+	}
+
+	value := g.expr.GenerateValueOfType(typ.Value)
+	x := value
+	if typ.Key != ir.IntType || randutil.Bool(g.rand) {
+		x = ir.NewKeyValue(g.expr.GenerateValueOfType(typ.Key), value)
+	}
+	yield := ir.NewYield(x)
+	if randutil.Chance(g.rand, 0.3) {
+		g.pushVarAssign(&ir.NullableType{X: typ.Send}, yield)
+		return
+	}
+	g.currentBlock.Args = append(g.currentBlock.Args, yield)
+}
+
+// pushYieldFromStmt delegates to one of the previously declared generators
+// that yield the same key and value types.
+// Generators with int keys can also delegate to arrays.
+func (g *generator) pushYieldFromStmt() bool {
+	typ := g.currentGenerator.Result.(*ir.GeneratorType)
+
+	var candidates []*ir.FuncType
+	for _, other := range g.generatorFuncs {
+		if other == g.currentGenerator {
+			break
+		}
+		otherType := other.Result.(*ir.GeneratorType)
+		if typesIdentical(typ.Key, otherType.Key) && typesIdentical(typ.Value, otherType.Value) {
+			candidates = append(candidates, other)
+		}
+	}
+
+	if len(candidates) != 0 && randutil.Chance(g.rand, 0.7) {
+		fn := randutil.Elem(g.rand, candidates)
+		args, ok := g.expr.callArgs(fn)
+		if !ok {
+			return false
+		}
+		yieldFrom := ir.NewYieldFrom(ir.NewCall(ir.NewName(fn.Name), args...))
+		if randutil.Bool(g.rand) {
+			g.pushVarAssign(fn.Result.(*ir.GeneratorType).Return, yieldFrom)
+		} else {
+			g.currentBlock.Args = append(g.currentBlock.Args, yieldFrom)
+		}
+		return true
+	}
+
+	if typ.Key == ir.IntType {
+		array := g.expr.GenerateValueOfType(&ir.ArrayType{Elem: typ.Value})
+		g.currentBlock.Args = append(g.currentBlock.Args, ir.NewYieldFrom(array))
+		return true
+	}
+
+	return false
+}
+
+// pushGeneratorConsumeStmt calls a generator function and consumes the results
+// with foreach, Generator methods or iterator_to_array().
+func (g *generator) pushGeneratorConsumeStmt() bool {
+	if len(g.generatorFuncs) == 0 {
+		return false
+	}
+	fn := randutil.Elem(g.rand, g.generatorFuncs)
+	typ := fn.Result.(*ir.GeneratorType)
+	args, ok := g.expr.callArgs(fn)
+	if !ok {
+		return false
+	}
+	call := ir.NewCall(ir.NewName(fn.Name), args...)
+
+	switch g.rand.Intn(3) {
+	case 0:
+		g.pushForeachStmt(call, typ.Key, typ.Value)
+
+	case 1:
+		gen := ir.NewVar(g.genVarname(true), typ)
+		method := func(name string, args ...*ir.Node) *ir.Node {
+			return ir.NewCall(ir.NewMemberAccess(gen, name), args...)
+		}
+		var advance *ir.Node
+		if randutil.Bool(g.rand) {
+			advance = method("next")
+		} else {
+			advance = g.varDumpCall(method("send", g.expr.GenerateValueOfType(typ.Send)))
+		}
+		body := ir.NewBlock(
+			g.varDumpCall(method("key")),
+			g.varDumpCall(method("current")),
+			advance,
+		)
+		g.currentBlock.Args = append(g.currentBlock.Args,
+			ir.NewAssign(gen, call),
+			ir.NewWhile(method("valid"), body),
+			g.varDumpCall(method("getReturn")))
+
+	default:
+		preserveKeys := ir.NewBoolLit(randutil.Bool(g.rand))
+		g.currentBlock.Args = append(g.currentBlock.Args,
+			g.varDumpCall(newSimpleCall("iterator_to_array", call, preserveKeys)))
+	}
+
+	return true
+}
+
+// pushForeachStmt iterates over x, the loop variables are dumped on every iteration.
+func (g *generator) pushForeachStmt(x *ir.Node, keyType, valueType ir.Type) {
+	prevInLoop := g.insideLoop
+	prevCurrentBlock := g.currentBlock
+	g.insideLoop = true
+	g.scope.Enter()
+
+	var keyVar *ir.Node
+	if randutil.Bool(g.rand) {
+		keyVar = ir.NewVar(g.genVarname(false), keyType)
+		g.scope.PushVar(keyVar.Value.(string), keyType)
+	}
+	valueVar := ir.NewVar(g.genVarname(false), valueType)
+	g.scope.PushVar(valueVar.Value.(string), valueType)
+
+	as := valueVar
+	body := ir.NewBlock()
+	if keyVar != nil {
+		as = ir.NewKeyValue(keyVar, valueVar)
+		body.Args = append(body.Args, g.varDumpCall(keyVar))
+	}
+	body.Args = append(body.Args, g.varDumpCall(valueVar))
+
+	g.currentBlock = body
+	numStatements := randutil.IntRange(g.rand, 0, 2)
+	for i := 0; i < numStatements; i++ {
+		g.pushStatement()
+	}
+
+	g.scope.Leave()
+	g.insideLoop = prevInLoop
+	g.currentBlock = prevCurrentBlock
+	g.currentBlock.Args = append(g.currentBlock.Args, ir.NewForeach(x, as, body))
+}
+
+// pushRefStmt creates a reference to a variable, an array element or an object field
+// and modifies the referenced value through it.
+func (g *generator) pushRefStmt() bool {
+	if !g.config.PHPOnly {
+		return g.pushForeachRefStmt()
+	}
+	switch g.rand.Intn(4) {
+	case 0:
+		return g.pushVarRefStmt()
+	case 1:
+		return g.pushArrayElemRefStmt()
+	case 2:
+		return g.pushFieldRefStmt()
+	default:
+		return g.pushForeachRefStmt()
+	}
+}
+
+func (g *generator) pushVarRefStmt() bool {
+	x := g.expr.findRandomVar(func(v *scopeVar) bool {
+		return v.name != "this" && canDump(v.typ)
+	})
+	if x == nil {
+		return false
+	}
+	xVar := ir.NewVar(x.name, x.typ)
+	ref := ir.NewVar(g.genVarname(false), x.typ)
+	g.currentBlock.Args = append(g.currentBlock.Args,
+		ir.NewAssign(ref, ir.NewRef(xVar)),
+		ir.NewAssign(ref, g.expr.GenerateValueOfType(x.typ)),
+		g.varDumpCall(xVar))
+	g.scope.PushVar(ref.Value.(string), x.typ)
+	return true
+}
+
+// pushArrayElemRefStmt makes a reference to an array element and then copies the array.
+// The referenced element stays shared between the copies: a reference
+// inside an array is not separated by the copy-on-write.
+func (g *generator) pushArrayElemRefStmt() bool {
+	elemType := g.expr.PickScalarType()
+	typ := &ir.ArrayType{Elem: elemType}
+	elems := make([]*ir.Node, randutil.IntRange(g.rand, 1, 4))
+	for i := range elems {
+		elems[i] = g.expr.GenerateValueOfType(elemType)
+	}
+	arr := ir.NewVar(g.genVarname(false), typ)
+	ref := ir.NewVar(g.genVarname(false), elemType)
+	index := ir.NewIntLit(int64(g.rand.Intn(len(elems))))
+	g.currentBlock.Args = append(g.currentBlock.Args,
+		ir.NewAssign(arr, &ir.Node{Op: ir.OpArrayLit, Args: elems}),
+		ir.NewAssign(ref, ir.NewRef(ir.NewIndex(arr, index))))
+	var arrCopy *ir.Node
+	if randutil.Chance(g.rand, 0.7) {
+		arrCopy = ir.NewVar(g.genVarname(false), typ)
+		g.currentBlock.Args = append(g.currentBlock.Args, ir.NewAssign(arrCopy, arr))
+	}
+	g.currentBlock.Args = append(g.currentBlock.Args,
+		ir.NewAssign(ref, g.expr.GenerateValueOfType(elemType)),
+		g.varDumpCall(arr))
+	if arrCopy != nil {
+		g.currentBlock.Args = append(g.currentBlock.Args, g.varDumpCall(arrCopy))
+	}
+	return true
+}
+
+func (g *generator) pushFieldRefStmt() bool {
+	obj := g.expr.findRandomVar(func(v *scopeVar) bool {
+		_, ok := v.typ.(*ir.ClassType)
+		return ok
+	})
+	if obj == nil {
+		return false
+	}
+	var fields []*ir.TypeField
+	for c := obj.typ.(*ir.ClassType); c != nil; c = c.Parent {
+		for i := range c.Fields {
+			field := &c.Fields[i]
+			if !field.Flags.IsStatic() && canDump(field.Type) && g.expr.canAccess(field.Flags, c) {
+				fields = append(fields, field)
+			}
+		}
+	}
+	if len(fields) == 0 {
+		return false
+	}
+	field := randutil.Elem(g.rand, fields)
+	fieldAccess := ir.NewMemberAccess(ir.NewVar(obj.name, obj.typ), field.Name)
+	ref := ir.NewVar(g.genVarname(false), field.Type)
+	g.currentBlock.Args = append(g.currentBlock.Args,
+		ir.NewAssign(ref, ir.NewRef(fieldAccess)),
+		ir.NewAssign(ref, g.expr.GenerateValueOfType(field.Type)),
+		g.varDumpCall(fieldAccess))
+	return true
+}
+
+// pushForeachRefStmt modifies an array inside a foreach by reference.
+// The loop variable is not always unset afterwards, so the next foreach
+// that uses the same variable name overwrites the last array element.
+func (g *generator) pushForeachRefStmt() bool {
+	x := g.expr.findRandomVar(func(v *scopeVar) bool {
+		typ, ok := v.typ.(*ir.ArrayType)
+		return ok && v.name != "this" && canDump(typ.Elem)
+	})
+	if x == nil {
+		return false
+	}
+	arr := ir.NewVar(x.name, x.typ)
+	elemType := x.typ.(*ir.ArrayType).Elem
+	v := ir.NewVar(g.genVarname(false), elemType)
+	body := ir.NewBlock(ir.NewAssign(v, g.expr.GenerateValueOfType(elemType)))
+	g.currentBlock.Args = append(g.currentBlock.Args, ir.NewForeach(arr, ir.NewRef(v), body))
+	if randutil.Bool(g.rand) {
+		g.currentBlock.Args = append(g.currentBlock.Args, ir.NewUnset(v))
+	}
+	g.currentBlock.Args = append(g.currentBlock.Args,
+		ir.NewForeach(arr, v, ir.NewBlock(g.varDumpCall(v))),
+		g.varDumpCall(arr))
+	return true
+}
+
+// pushArrayStmt modifies an array variable and dumps the result,
+// so the element order and the key conversions are observable.
+func (g *generator) pushArrayStmt() bool {
+	v := g.expr.findRandomVar(func(v *scopeVar) bool {
+		_, ok := v.typ.(*ir.ArrayType)
+		return ok && v.name != "this"
+	})
+	if v == nil {
+		return false
+	}
+	arr := ir.NewVar(v.name, v.typ)
+	typ := v.typ.(*ir.ArrayType)
+	key := g.expr.arrayKey(typ.KeyType())
+
+	switch g.rand.Intn(6) {
+	case 0:
+		// Appending to a string-keyed array would add an int key.
+		if typ.Key == ir.StringType {
+			return false
+		}
+		lhs := ir.NewEmptyIndex(arr)
+		g.currentBlock.Args = append(g.currentBlock.Args, ir.NewAssign(lhs, g.expr.GenerateValueOfType(typ.Elem)))
+	case 1:
+		// Setting or unsetting a random key of a list would leave it
+		// with non-sequential keys while it's still typed as a list.
+		if typ.Key == nil {
+			return false
+		}
+		lhs := ir.NewIndex(arr, key)
+		g.currentBlock.Args = append(g.currentBlock.Args, ir.NewAssign(lhs, g.expr.GenerateValueOfType(typ.Elem)))
+	case 2:
+		// A missing outer element is created as an empty array.
+		elemType, ok := typ.Elem.(*ir.ArrayType)
+		if !ok || typ.Key == nil || elemType.Key == nil {
+			return false
+		}
+		lhs := ir.NewIndex(ir.NewIndex(arr, key), g.expr.arrayKey(elemType.KeyType()))
+		g.currentBlock.Args = append(g.currentBlock.Args, ir.NewAssign(lhs, g.expr.GenerateValueOfType(elemType.Elem)))
+	case 3:
+		if typ.Key == nil {
+			return false
+		}
+		g.currentBlock.Args = append(g.currentBlock.Args, ir.NewUnset(ir.NewIndex(arr, key)))
+	case 4:
+		// isset() is false for the null elements while array_key_exists() is true.
+		var cond *ir.Node
+		if randutil.Bool(g.rand) {
+			cond = ir.NewIsset(ir.NewIndex(arr, key))
+		} else {
+			cond = newSimpleCall("array_key_exists", key, arr)
+		}
+		if !canDump(typ.Elem) {
+			g.currentBlock.Args = append(g.currentBlock.Args, g.varDumpCall(cond))
+			return true
+		}
+		body := ir.NewBlock(g.varDumpCall(ir.NewIndex(arr, key)))
+		g.currentBlock.Args = append(g.currentBlock.Args, ir.NewIf(cond, body))
+		return true
+	default:
+		g.pushForeachStmt(arr, typ.KeyType(), typ.Elem)
+		return true
+	}
+
+	if canDump(typ) {
+		g.currentBlock.Args = append(g.currentBlock.Args, g.varDumpCall(arr))
+	}
+	return true
+}
+
+// pushListStmt destructures a tuple or an array into new variables.
+func (g *generator) pushListStmt() {
+	short := randutil.Bool(g.rand)
+	switch g.rand.Intn(4) {
+	case 0:
+		g.pushListForeachStmt(short)
+		return
+	case 1:
+		g.pushArrayListStmt(short)
+		return
+	}
+
+	var rhs *ir.Node
+	var typ *ir.TupleType
+	v := g.expr.findRandomVar(func(v *scopeVar) bool {
+		_, ok := v.typ.(*ir.TupleType)
+		return ok
+	})
+	if v != nil && randutil.Bool(g.rand) {
+		typ = v.typ.(*ir.TupleType)
+		rhs = ir.NewVar(v.name, v.typ)
+	} else {
+		typ = g.expr.pickTupleType(2).(*ir.TupleType)
+		rhs = g.expr.GenerateValueOfType(typ)
+	}
+	var vars []*ir.Node
+	list := g.tupleListPattern(typ, short, &vars)
+	g.currentBlock.Args = append(g.currentBlock.Args, ir.NewAssign(list, rhs))
+	g.pushListVars(vars)
+}
+
+// tupleListPattern creates a list() pattern for the typ elements.
+// Some elements are skipped, the nested tuples can be destructured recursively.
+func (g *generator) tupleListPattern(typ *ir.TupleType, short bool, vars *[]*ir.Node) *ir.Node {
+	list := ir.NewList(short)
+	for _, elemType := range typ.Elems {
+		if randutil.Chance(g.rand, 0.2) {
+			list.Args = append(list.Args, nil)
+			continue
+		}
+		if elemType, ok := elemType.(*ir.TupleType); ok && randutil.Bool(g.rand) {
+			list.Args = append(list.Args, g.tupleListPattern(elemType, short, vars))
+			continue
+		}
+		v := ir.NewVar(g.genVarname(false), elemType)
+		*vars = append(*vars, v)
+		list.Args = append(list.Args, v)
+	}
+
+	// Trailing skipped elements are removed, at least one element is required.
+	for len(list.Args) != 0 && list.Args[len(list.Args)-1] == nil {
+		list.Args = list.Args[:len(list.Args)-1]
+	}
+	if len(list.Args) == 0 {
+		v := ir.NewVar(g.genVarname(false), typ.Elems[0])
+		*vars = append(*vars, v)
+		list.Args = append(list.Args, v)
+	}
+	return list
+}
+
+// pushArrayListStmt destructures an array literal, either by position or by keys.
+// Only the existing elements are destructured.
+func (g *generator) pushArrayListStmt(short bool) {
+	elemType := g.expr.PickType()
+	numElems := randutil.IntRange(g.rand, 1, 4)
+	keyed := randutil.Bool(g.rand)
+
+	rhs := &ir.Node{Op: ir.OpArrayLit}
+	list := ir.NewList(short)
+	var vars []*ir.Node
+	keys := map[string]bool{}
+	for i := 0; i < numElems; i++ {
+		elem := g.expr.GenerateValueOfType(elemType)
+		if !keyed {
+			rhs.Args = append(rhs.Args, elem)
+			if i != 0 && i != numElems-1 && randutil.Chance(g.rand, 0.2) {
+				list.Args = append(list.Args, nil)
+				continue
+			}
+			v := ir.NewVar(g.genVarname(false), elemType)
+			vars = append(vars, v)
+			list.Args = append(list.Args, v)
+			continue
+		}
+
+		key := ir.NewStringLit(g.expr.valueGenerator.StringKeyValue())
+		if keys[key.Value.(string)] {
+			continue
+		}
+		keys[key.Value.(string)] = true
+		rhs.Args = append(rhs.Args, ir.NewKeyValue(key, elem))
+		if randutil.Chance(g.rand, 0.3) && len(list.Args) != 0 {
+			continue
+		}
+		v := ir.NewVar(g.genVarname(false), elemType)
+		vars = append(vars, v)
+		list.Args = append(list.Args, ir.NewKeyValue(key, v))
+	}
+	if keyed {
+		g.rand.Shuffle(len(list.Args), func(i, j int) {
+			list.Args[i], list.Args[j] = list.Args[j], list.Args[i]
+		})
+	}
+
+	g.currentBlock.Args = append(g.currentBlock.Args, ir.NewAssign(list, rhs))
+	g.pushListVars(vars)
+}
+
+// pushListForeachStmt iterates over an array of tuples with a list() pattern.
+func (g *generator) pushListForeachStmt(short bool) {
+	var arr *ir.Node
+	var elemType *ir.TupleType
+	v := g.expr.findRandomVar(func(v *scopeVar) bool {
+		typ, ok := v.typ.(*ir.ArrayType)
+		if !ok || v.name == "this" {
+			return false
+		}
+		_, ok = typ.Elem.(*ir.TupleType)
+		return ok
+	})
+	if v != nil {
+		arr = ir.NewVar(v.name, v.typ)
+		elemType = v.typ.(*ir.ArrayType).Elem.(*ir.TupleType)
+	} else {
+		elemType = g.expr.pickTupleType(2).(*ir.TupleType)
+		arr = g.expr.GenerateValueOfType(&ir.ArrayType{Elem: elemType})
+	}
+
+	prevInLoop := g.insideLoop
+	prevCurrentBlock := g.currentBlock
+	g.insideLoop = true
+	g.scope.Enter()
+
+	var vars []*ir.Node
+	list := g.tupleListPattern(elemType, short, &vars)
+	body := ir.NewBlock()
+	g.currentBlock = body
+	g.pushListVars(vars)
+	numStatements := randutil.IntRange(g.rand, 0, 2)
+	for i := 0; i < numStatements; i++ {
+		g.pushStatement()
+	}
+
+	g.scope.Leave()
+	g.insideLoop = prevInLoop
+	g.currentBlock = prevCurrentBlock
+	g.currentBlock.Args = append(g.currentBlock.Args, ir.NewForeach(arr, list, body))
+}
+
+// pushListVars adds the destructured variables to the scope and dumps them.
+func (g *generator) pushListVars(vars []*ir.Node) {
+	for _, v := range vars {
+		g.scope.PushVar(v.Value.(string), v.Type)
+		if canDump(v.Type) {
+			g.currentBlock.Args = append(g.currentBlock.Args, g.varDumpCall(v))
+		}
+	}
+}
+
+// pushVarAssign assigns x to a new variable of the specified type.
+func (g *generator) pushVarAssign(typ ir.Type, x *ir.Node) {
+	name := g.genVarname(false)
+	assign := ir.NewAssign(ir.NewVar(name, typ), x)
+	assign.Value = &phpdoc.VarTag{VarName: "$" + name, Type: typ.String()}
+	g.currentBlock.Args = append(g.currentBlock.Args, assign)
+	g.scope.PushVar(name, typ)
+}
+
 func (g *generator) pushAssignStmt() {
 	lhs, typ := g.expr.PickLvalue()
 	if lhs == nil {
@@ -484,7 +1756,9 @@ func (g *generator) pushAssignStmt() {
 	if typ, ok := typ.(*ir.ScalarType); ok && randutil.Bool(g.rand) {
 		var opChoice []ir.Op
 		switch typ.Kind {
-		case ir.ScalarInt, ir.ScalarFloat:
+		case ir.ScalarInt:
+			opChoice = []ir.Op{ir.OpAdd, ir.OpSub, ir.OpBitAnd, ir.OpBitOr, ir.OpBitXor}
+		case ir.ScalarFloat:
 			opChoice = []ir.Op{ir.OpAdd, ir.OpSub}
 		case ir.ScalarString:
 			opChoice = []ir.Op{ir.OpConcat}
@@ -497,6 +1771,11 @@ func (g *generator) pushAssignStmt() {
 	}
 	var assign *ir.Node
 	rhs := g.expr.GenerateValueOfType(typ)
+	if (op == ir.OpAdd || op == ir.OpSub) && typesIdentical(typ, ir.IntType) {
+		// The int add and sub can overflow to float,
+		// a bounded rhs keeps the generated values far from the limits.
+		rhs = ir.NewMod(ir.NewParens(rhs), ir.NewIntLit(0x7fffffff))
+	}
 	if op != ir.OpInvalid {
 		assign = ir.NewAssignModify(op, lhs, rhs)
 	} else {
@@ -505,6 +1784,56 @@ func (g *generator) pushAssignStmt() {
 	g.currentBlock.Args = append(g.currentBlock.Args, assign)
 }
 
+func (g *generator) pushSortStmt() bool {
+	v := g.expr.findRandomVar(func(v *scopeVar) bool {
+		// usort reindexes the keys, so only the lists keep their type.
+		arrayType, ok := v.typ.(*ir.ArrayType)
+		if !ok || arrayType.Key != nil || v.name == "this" {
+			return false
+		}
+		elemType, ok := arrayType.Elem.(*ir.ScalarType)
+		return ok && elemType.Kind != ir.ScalarFloat
+	})
+	if v == nil {
+		return false
+	}
+
+	// The comparison function must be consistent,
+	// otherwise the result would depend on the sorting algorithm.
+	elemType := v.typ.(*ir.ArrayType).Elem
+	fn := &ir.FuncType{
+		Params:     []ir.TypeField{{Name: "a", Type: elemType}, {Name: "b", Type: elemType}},
+		MinArgsNum: 2,
+		Result:     ir.IntType,
+	}
+	g.expr.markTypeHints(fn)
+	x := ir.NewVar("a", elemType)
+	y := ir.NewVar("b", elemType)
+	if randutil.Bool(g.rand) {
+		x, y = y, x
+	}
+	var cmp *ir.Node
+	if elemType == ir.StringType {
+		cmp = newSimpleCall("strcmp", x, y)
+	} else {
+		cmp = ir.NewSpaceship(x, y)
+	}
+	var cmpFunc *ir.Node
+	if randutil.Bool(g.rand) {
+		cmpFunc = ir.NewArrowFunc(fn, cmp)
+	} else {
+		cmpFunc = ir.NewClosure(fn, ir.NewBlock(ir.NewReturn(cmp)))
+	}
+
+	// Note: uasort is not used as it would make the sort stability observable.
+	arr := ir.NewVar(v.name, v.typ)
+	g.currentBlock.Args = append(g.currentBlock.Args, newSimpleCall("usort", arr, cmpFunc))
+	if canDump(v.typ) {
+		g.currentBlock.Args = append(g.currentBlock.Args, g.varDumpCall(arr))
+	}
+	return true
+}
+
 func (g *generator) pushVarDump() bool {
 	for attempts := 0; attempts < 5; attempts++ {
 		typ := g.expr.PickType()
@@ -0,0 +1,170 @@
+package irgen
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/quasilyte/phpsmith/irprint"
+	"github.com/quasilyte/phpsmith/randutil"
+)
+
+var flagUpdate = flag.Bool("update", false,
+	`whether to rewrite the testdata/golden dir and the testdata/golden.txt with the current output`)
+
+// goldenPrograms are the programs with the printed output pinned in testdata/golden.
+// They're among the smallest programs for the first few hundred seeds.
+var goldenPrograms = []struct {
+	seed int64
+	php8 bool
+}{
+	{seed: 75, php8: false},
+	{seed: 84, php8: true},
+}
+
+// goldenSeeds are the seeds with the printed output hashes pinned in testdata/golden.txt.
+var goldenSeeds = []int64{1, 2, 3, 42, 1651182107}
+
+type printedFile struct {
+	name string
+	data []byte
+}
+
+// printProgram generates and prints a program the way "phpsmith generate" does,
+// except for the printer modes that are left disabled.
+func printProgram(seed int64, php8 bool) []printedFile {
+	random := rand.New(rand.NewSource(seed))
+	program := CreateProgram(&Config{Rand: random, PHP8: php8})
+	printerConfig := &irprint.Config{Rand: randutil.SubRand(seed, "irprint")}
+
+	files := make([]printedFile, len(program.Files))
+	for i, f := range program.Files {
+		var buf bytes.Buffer
+		irprint.FprintFile(&buf, f.Name, f.Nodes, printerConfig)
+		files[i] = printedFile{name: f.Name, data: buf.Bytes()}
+	}
+	return files
+}
+
+func TestReproducible(t *testing.T) {
+	for seed := int64(1); seed <= 20; seed++ {
+		php8 := seed%2 == 0
+		files1 := printProgram(seed, php8)
+		files2 := printProgram(seed, php8)
+		if len(files1) != len(files2) {
+			t.Fatalf("seed %d: generated %d and %d files", seed, len(files1), len(files2))
+		}
+		for i, f := range files1 {
+			if f.name != files2[i].name || !bytes.Equal(f.data, files2[i].data) {
+				t.Fatalf("seed %d: %s contents differ between runs", seed, f.name)
+			}
+		}
+	}
+}
+
+func TestGolden(t *testing.T) {
+	for _, p := range goldenPrograms {
+		dir := filepath.Join("testdata", "golden", fmt.Sprintf("%d_php7", p.seed))
+		if p.php8 {
+			dir = filepath.Join("testdata", "golden", fmt.Sprintf("%d_php8", p.seed))
+		}
+		files := printProgram(p.seed, p.php8)
+		if *flagUpdate {
+			if err := os.RemoveAll(dir); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			for _, f := range files {
+				if err := os.WriteFile(filepath.Join(dir, f.name), f.data, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != len(files) {
+			t.Errorf("%s: have %d files, want %d", dir, len(files), len(entries))
+		}
+		for _, f := range files {
+			want, err := os.ReadFile(filepath.Join(dir, f.name))
+			if err != nil {
+				t.Errorf("%s: unexpected file %s", dir, f.name)
+				continue
+			}
+			if diff := firstLineDiff(f.data, want); diff != "" {
+				t.Errorf("%s mismatch (run with -update if the change is intended):\n%s", filepath.Join(dir, f.name), diff)
+			}
+		}
+	}
+}
+
+// firstLineDiff describes the first line that differs between have and want.
+// It returns an empty string if they're equal.
+func firstLineDiff(have, want []byte) string {
+	haveLines := strings.Split(string(have), "\n")
+	wantLines := strings.Split(string(want), "\n")
+	for i := 0; i < len(haveLines) || i < len(wantLines); i++ {
+		var haveLine, wantLine string
+		if i < len(haveLines) {
+			haveLine = haveLines[i]
+		}
+		if i < len(wantLines) {
+			wantLine = wantLines[i]
+		}
+		if i >= len(haveLines) || i >= len(wantLines) || haveLine != wantLine {
+			return fmt.Sprintf("line %d:\nhave: %q\nwant: %q", i+1, haveLine, wantLine)
+		}
+	}
+	return ""
+}
+
+// TestGoldenHashes is an extra determinism check over more seeds,
+// it only pins the printed output hashes.
+func TestGoldenHashes(t *testing.T) {
+	var lines []string
+	for _, seed := range goldenSeeds {
+		for _, php8 := range []bool{false, true} {
+			files := printProgram(seed, php8)
+			for _, f := range files {
+				sum := sha256.Sum256(f.data)
+				lines = append(lines, fmt.Sprintf("%d php8=%v %s %x", seed, php8, f.name, sum[:8]))
+			}
+		}
+	}
+	have := strings.Join(lines, "\n") + "\n"
+
+	goldenFile := filepath.Join("testdata", "golden.txt")
+	if *flagUpdate {
+		if err := os.WriteFile(goldenFile, []byte(have), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	data, err := os.ReadFile(goldenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantLines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	for i, line := range lines {
+		if i >= len(wantLines) {
+			t.Fatalf("unexpected golden line: %s", line)
+		}
+		if line != wantLines[i] {
+			t.Fatalf("golden output mismatch (run with -update if the change is intended):\nhave: %s\nwant: %s", line, wantLines[i])
+		}
+	}
+	if len(wantLines) != len(lines) {
+		t.Fatalf("have %d golden lines, want %d", len(lines), len(wantLines))
+	}
+}
@@ -7,12 +7,118 @@ import (
 )
 
 type Config struct {
+	// Rand is a root random source.
+	// The per-class, per-file and per-function random sub-streams
+	// are derived from its first value, see randutil.SubRand.
 	Rand *rand.Rand
+
+	// SubRand creates the random sub-streams; randutil.SubRand is used if it's nil.
+	// Use randutil.ChoiceRecorder or randutil.ChoiceReplayer methods
+	// to record or replay all decisions made during the generation.
+	SubRand func(seed int64, name string) *rand.Rand
+
+	// PHP8 permits the syntax that is only available since PHP 8,
+	// like the constructor property promotion.
+	PHP8 bool
+
+	// PHPOnly permits the constructs that KPHP doesn't support, like the local
+	// variable references and the closures capturing by reference.
+	// Without it, only the by-reference params and foreach are generated.
+	PHPOnly bool
+
+	// ExprWeights scale the expression choice frequencies, the keys
+	// are the choice names (see ExprChoiceNames). A missing choice has a weight of 1,
+	// a zero weight disables the choice.
+	ExprWeights map[string]float64
+
+	// DisabledStmts are the statement kinds (see StmtKinds) that are never generated,
+	// a variable declaration is generated instead.
+	DisabledStmts []string
+
+	// SingleFile makes the strict types mode a program-wide decision,
+	// so the program can be printed as one file without changing its semantics.
+	SingleFile bool
+
+	// DisabledBuiltins are the phpfunc builtins that are never called.
+	// The runtime helpers and the builtins that are not picked at random,
+	// like the type checks, are still used.
+	DisabledBuiltins []string
 }
 
 type Program struct {
 	Files        []*File
 	RuntimeFiles []*RuntimeFile
+
+	Metadata Metadata
+
+	// ExprChoices counts the ops produced by every expression choice,
+	// the keys are the choice names.
+	ExprChoices map[string]map[ir.Op]int
+}
+
+// Metadata describes the choices made during the program generation
+// that are not obvious from the generated code.
+type Metadata struct {
+	Typing TypingStyle `json:"typing"`
+
+	// StrictTypesFiles lists the files that use declare(strict_types=1).
+	StrictTypesFiles []string `json:"strict_types_files"`
+
+	// ExprWeights are the Config.ExprWeights the program was generated with.
+	ExprWeights map[string]float64 `json:"expr_weights,omitempty"`
+
+	// DisabledStmts are the Config.DisabledStmts the program was generated with.
+	DisabledStmts []string `json:"disabled_stmts,omitempty"`
+
+	// DisabledBuiltins are the Config.DisabledBuiltins the program was generated with.
+	DisabledBuiltins []string `json:"disabled_builtins,omitempty"`
+}
+
+// TypingStyle describes how the types are expressed in the generated code.
+// The phpdoc comments are always generated.
+type TypingStyle int
+
+const (
+	// TypingPhpdoc uses only the phpdoc comments.
+	TypingPhpdoc TypingStyle = iota
+
+	// TypingNative adds the native param and result type hints.
+	TypingNative
+
+	// TypingProperties adds the typed properties (PHP 7.4+).
+	TypingProperties
+
+	// TypingNativeAndProperties combines TypingNative and TypingProperties.
+	TypingNativeAndProperties
+)
+
+func (style TypingStyle) String() string {
+	switch style {
+	case TypingPhpdoc:
+		return "phpdoc"
+	case TypingNative:
+		return "native"
+	case TypingProperties:
+		return "properties"
+	case TypingNativeAndProperties:
+		return "native+properties"
+	default:
+		return "unknown"
+	}
+}
+
+func (style TypingStyle) MarshalText() ([]byte, error) {
+	return []byte(style.String()), nil
+}
+
+// NativeHints reports whether the funcs have native type hints.
+func (style TypingStyle) NativeHints() bool {
+	return style == TypingNative || style == TypingNativeAndProperties
+}
+
+// TypedProperties reports whether the class fields have native types.
+func (style TypingStyle) TypedProperties() bool {
+	return style == TypingProperties || style == TypingNativeAndProperties
 }
 
 type RuntimeFile struct {
@@ -21,10 +127,29 @@ type RuntimeFile struct {
 	Contents []byte
 }
 
-type File struct {
-	Name string
+// File is a generated PHP file.
+type File = ir.File
+
+// ExprChoiceNames returns the names of all expression choices, like "int/add".
+// The part before the slash is a type of the generated value,
+// "cond" being a bool value used as a condition.
+func ExprChoiceNames() []string {
+	g := newExprGenerator(&Config{}, nil, nil)
+	var names []string
+	for _, list := range []*exprChoiceList{&g.condChoices, &g.boolChoices, &g.intChoices, &g.floatChoices, &g.stringChoices} {
+		for _, o := range list.options {
+			names = append(names, o.name)
+		}
+	}
+	return names
+}
 
-	Nodes []ir.RootNode
+// StmtKinds returns the statement kinds that can be disabled.
+func StmtKinds() []string {
+	return []string{
+		"block", "if", "break", "continue", "var_dump", "assign", "loop", "switch",
+		"sort", "object_link", "try", "generator", "ref", "array", "list",
+	}
 }
 
 func CreateProgram(config *Config) *Program {
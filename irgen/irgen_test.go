@@ -0,0 +1,32 @@
+package irgen
+
+import (
+	"flag"
+	"math/rand"
+	"testing"
+
+	"github.com/quasilyte/phpsmith/ir"
+)
+
+var flagSeeds = flag.Int("seeds", 100,
+	`a number of seeds to generate the programs for, run with -seeds=5000 for a thorough check`)
+
+func TestValidatePrograms(t *testing.T) {
+	numSeeds := *flagSeeds
+	if testing.Short() {
+		numSeeds = 20
+	}
+	for seed := int64(1); seed <= int64(numSeeds); seed++ {
+		for _, php8 := range []bool{false, true} {
+			random := rand.New(rand.NewSource(seed))
+			program := CreateProgram(&Config{Rand: random, PHP8: php8, PHPOnly: seed%2 == 0})
+			errors := ir.Validate(program.Files)
+			for _, err := range errors {
+				t.Errorf("seed %d (php8=%v): %v", seed, php8, err)
+			}
+			if len(errors) != 0 {
+				return
+			}
+		}
+	}
+}
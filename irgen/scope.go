@@ -7,6 +7,10 @@ import (
 type scope struct {
 	vars   []scopeVar
 	depths []int
+
+	// funcStarts holds the vars offsets for the nested function scopes.
+	// Variables below the last offset are not visible inside the closure.
+	funcStarts []int
 }
 
 type scopeVar struct {
@@ -29,6 +33,16 @@ func (s *scope) Leave() {
 	s.vars = s.vars[:len(s.vars)-depth]
 }
 
+func (s *scope) EnterFunc() {
+	s.funcStarts = append(s.funcStarts, len(s.vars))
+	s.Enter()
+}
+
+func (s *scope) LeaveFunc() {
+	s.Leave()
+	s.funcStarts = s.funcStarts[:len(s.funcStarts)-1]
+}
+
 func (s *scope) PushParam(name string, typ ir.Type) {
 	s.vars = append(s.vars, scopeVar{name: name, typ: typ, isParam: true})
 	s.depths[len(s.depths)-1]++
@@ -57,8 +71,12 @@ func (s *scope) FindVarByName(name string) *scopeVar {
 }
 
 func (s *scope) FindVar(predicate func(v *scopeVar) bool) *scopeVar {
+	start := 0
+	if len(s.funcStarts) != 0 {
+		start = s.funcStarts[len(s.funcStarts)-1]
+	}
 	seen := make(map[string]struct{})
-	for i := len(s.vars) - 1; i >= 0; i-- {
+	for i := len(s.vars) - 1; i >= start; i-- {
 		v := &s.vars[i]
 		if _, ok := seen[v.name]; ok {
 			continue
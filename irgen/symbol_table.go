@@ -2,15 +2,24 @@ package irgen
 
 import (
 	"fmt"
+	"math/rand"
 	"sort"
 
 	"github.com/quasilyte/phpsmith/ir"
+	"github.com/quasilyte/phpsmith/randutil"
 )
 
 type symbolTable struct {
 	funcs   map[string]*ir.FuncType
 	classes map[string]*ir.ClassType
 
+	// classList holds the same classes as the classes map, in declaration order.
+	classList []*ir.ClassType
+
+	// exceptions are kept apart from the other classes,
+	// they're only used in throw statements and catch clauses.
+	exceptions []*ir.ClassType
+
 	sorted bool
 
 	boolFields   []fieldRef
@@ -18,6 +27,10 @@ type symbolTable struct {
 	floatFields  []fieldRef
 	stringFields []fieldRef
 	arrayFields  []fieldRef
+	staticFields []fieldRef
+
+	// objectFields are class-typed fields that are always initialized by the constructor.
+	objectFields []fieldRef
 
 	voidFuncs   []*ir.FuncType
 	boolFuncs   []*ir.FuncType
@@ -28,11 +41,15 @@ type symbolTable struct {
 }
 
 type fieldRef struct {
-	index int
-	class *ir.ClassType
+	index   int
+	isConst bool
+	class   *ir.ClassType
 }
 
 func (ref fieldRef) Get() *ir.TypeField {
+	if ref.isConst {
+		return &ref.class.Consts[ref.index]
+	}
 	return &ref.class.Fields[ref.index]
 }
 
@@ -94,18 +111,54 @@ func (symtab *symbolTable) FindFuncsOfType(typ ir.Type) []*ir.FuncType {
 
 }
 
-func (symtab *symbolTable) PickRandomClass() *ir.ClassType {
-	for _, c := range symtab.classes {
-		return c
+func (symtab *symbolTable) PickRandomClass(r *rand.Rand) *ir.ClassType {
+	if len(symtab.classList) == 0 {
+		return nil
+	}
+	return randutil.Elem(r, symtab.classList)
+}
+
+// ConcreteSubclasses returns all classes that can be instantiated
+// and used as a value of the c type.
+func (symtab *symbolTable) ConcreteSubclasses(c *ir.ClassType) []*ir.ClassType {
+	var result []*ir.ClassType
+	for _, other := range symtab.classList {
+		if other.Flags.IsAbstract() || other.Flags.IsInterface() {
+			continue
+		}
+		if other == c || other.IsSubclassOf(c) {
+			result = append(result, other)
+		}
+	}
+	return result
+}
+
+// RelatedClasses returns all classes that are in the same hierarchy as c.
+func (symtab *symbolTable) RelatedClasses(c *ir.ClassType) []*ir.ClassType {
+	var result []*ir.ClassType
+	for _, other := range symtab.classList {
+		if other == c || other.IsSubclassOf(c) || c.IsSubclassOf(other) {
+			result = append(result, other)
+		}
 	}
-	return nil
+	return result
 }
 
-func (symtab *symbolTable) DeclareClass(name string) {
+func (symtab *symbolTable) DeclareClass(name string) *ir.ClassType {
 	if symtab.classes[name] != nil {
 		panic(fmt.Sprintf("class %s is already declared", name))
 	}
-	symtab.classes[name] = &ir.ClassType{Name: name}
+	c := &ir.ClassType{Name: name}
+	symtab.classes[name] = c
+	symtab.classList = append(symtab.classList, c)
+	return c
+}
+
+// DeclareException declares an exception class that extends the parent exception.
+func (symtab *symbolTable) DeclareException(name string, parent *ir.ClassType) *ir.ClassType {
+	c := &ir.ClassType{Name: name, Parent: parent}
+	symtab.exceptions = append(symtab.exceptions, c)
+	return c
 }
 
 func (symtab *symbolTable) DefineClass(c *ir.ClassType) {
@@ -119,22 +172,33 @@ func (symtab *symbolTable) DefineClass(c *ir.ClassType) {
 	*declared = *c
 
 	for i, field := range c.Fields {
-		switch fieldType := field.Type.(type) {
-		case *ir.ScalarType:
-			switch fieldType.Kind {
-			case ir.ScalarBool:
-				symtab.boolFields = append(symtab.boolFields, fieldRef{index: i, class: c})
-			case ir.ScalarInt:
-				symtab.intFields = append(symtab.intFields, fieldRef{index: i, class: c})
-			case ir.ScalarFloat:
-				symtab.floatFields = append(symtab.floatFields, fieldRef{index: i, class: c})
-			case ir.ScalarString:
-				symtab.stringFields = append(symtab.stringFields, fieldRef{index: i, class: c})
-			}
+		ref := fieldRef{index: i, class: c}
+		if field.Flags.IsStatic() {
+			symtab.staticFields = append(symtab.staticFields, ref)
+		}
+		symtab.addFieldRef(ref)
+	}
+	for i := range c.Consts {
+		symtab.addFieldRef(fieldRef{index: i, isConst: true, class: c})
+	}
+}
 
-		case *ir.ArrayType:
-			symtab.arrayFields = append(symtab.arrayFields, fieldRef{index: i, class: c})
+func (symtab *symbolTable) addFieldRef(ref fieldRef) {
+	switch fieldType := ref.Get().Type.(type) {
+	case *ir.ScalarType:
+		switch fieldType.Kind {
+		case ir.ScalarBool:
+			symtab.boolFields = append(symtab.boolFields, ref)
+		case ir.ScalarInt:
+			symtab.intFields = append(symtab.intFields, ref)
+		case ir.ScalarFloat:
+			symtab.floatFields = append(symtab.floatFields, ref)
+		case ir.ScalarString:
+			symtab.stringFields = append(symtab.stringFields, ref)
 		}
+
+	case *ir.ArrayType:
+		symtab.arrayFields = append(symtab.arrayFields, ref)
 	}
 }
 
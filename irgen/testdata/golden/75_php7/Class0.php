@@ -0,0 +1,149 @@
+<?php
+class Class0 {
+  /** @var Class4 */
+  public $field0;
+  /** @var int */
+  private $field1;
+  /** @var float */
+  public $field2;
+  /** @var bool */
+  public $field3 = true;
+  /** @var Interface1 */
+  private $field4 = null;
+  /** @var callable(float,string):bool */
+  protected $field5;
+  /**
+   * @return callable(float,float,string):int
+   */
+  public function method0() {
+    if (!_visit_function("Class0::method0")) return fn($a0, $a1, $a2) => 1388306476;
+    /** @var (?(int[])) $v0 */ $v0 = array_count_values(array_keys([
+      ((int)(((int)5445)
+        * ((int)(-((int)(((int)(((new Class4( (int)(((int)16612)	* ((int)(-128412288)))))->field11) - ((int)(((int)(54804 + ((strnatcmp( "[\"val\"]", 'simple stringo000P!')) ^ ((int)((-255) - (-10672))))))	- (Class3::CONST0))))) - /**/ levenshtein(("hqqL<div/>{\"key\":1}WU~p{\"key\":1},ZGj000{{\"key\":1}!"), /* comment */(new Class3())->field4))))))),
+      (true),
+    ]));
+    $v1 = new Class2((new Class2((make_positive_inf()), fn() => " 5|zsimple stringD ハロー・ワールド+``"))->field2, fn() => "\000");
+    $v2 = (new Class3())->field4;
+
+    $v1 = new Class2(/* comment */((((new Class3())->field4 === ((string)(_safe_float_div((make_nan()), /*
+*/0.6280617925370848)))) ? (0.6961837040139428) : (make_positive_inf()))), /* comment */fn() => ((rawurldecode( ((string)(!(is_infinite(0.3676594539842899) && (true  && false)) ? (((levenshtein( "\"24", "]")) |  ((((int)((-9284120) -  (15581)))) | ((int)((255) ** (-61617)))))) : (strcmp("<@(A<p>T</p>k24:", /**/ ('m')))))  . (("If8") .	("``")))) . "0x1f"),);
+    return function ($a3, $a4, $a5) {
+      return ((int)(_safe_int_div(strcasecmp((string)$a5[-1], $a5), ((int)((crc32('ib000M9') ^ strnatcmp( Class1::CONST0,
+				 ("o2<div/>2B")))	- ((int)(-1))))))) /* comment */ ^ ((int)(((1468148377)) ** ((int)((-52400) + crc32($a5)))));
+		};
+  }
+
+  /**
+   * @param Interface0 $p0
+   * @param tuple(int,bool,bool,string,Class2,callable(string):bool,array<mixed,Class2>) $p1
+	 * @param ((string|bool)[]) $p2
+   * @param Interface0 $p3
+   * @param int $p4
+   * @param float $p5
+   * @return Class3
+	 */
+  public static function method1($p0, $p1, $p2, $p3, &$p4, $p5) {
+		if (!_visit_function('Class0::method1')) return null;
+    foreach ($p2 as &$v0) {
+
+      $v0 = false;
+    }
+    foreach ($p2 as $v0) {
+      dump_with_pos( __FILE__, __LINE__,	$v0);
+		}
+		dump_with_pos( __FILE__, /* comment */ __LINE__,  $p2);
+    $v1 = /**/ "[L-/|simple stringv}q<``";
+    $p4 = -1;
+    return new Class3();
+  }
+
+  /**
+   * @param float $p0
+   * @param float $p1
+   * @param string $p2
+   * @param bool $p3
+	 * @return tuple(tuple(int,string,int,float),bool,tuple(int,bool,int,string,(bool|string),int,bool,Class4,bool),callable(string):bool,(bool|float),callable(float,string,float):float,tuple(float,float,string,tuple(string,float,int,int,int,float),float,(?int),float),int,string)
+   */
+  public function method2(&$p0, &$p1, $p2, $p3) {
+    if (!_visit_function(/***/"Class0::method2")) return tuple(tuple(-14874, '\'\'', -255, /**/0.7408312923334985), true, tuple(/* comment */-21534, true, 48146, /* comment */"G2*",	"us", /* comment */255, false, null, false), fn($a0) => true, /**/false,	fn($a1, $a2, $a3) => 21948.293242,
+      tuple(0.00043,  248.4664717977334, "4uA", /*
+*/tuple(" ", -2222.9999, /**/-23768,  0, -48706, /*
+*/2.326564451720026e+06), 56.535100065723036, 17966786728, 0.0), 9284128, "]o(*");
+    $v0 /***/ = ((string)$p2[(((($p2) === (("{$p1}-1236cz000dU`,}SR") . basename($p2,	 '-R'))) ? (int)(_safe_int_div(((int)(9284128	+ /*
+*/ 21578)), (strcasecmp(((("{$p1}{$p1}{$p1}Q``''kgGL}!") . "Kh1\n2,{\"key\":1}") . ((string)((ceil($p1))	+ sinh(0.957910997174193)))), $p2) ^ ((int)((count(array(
+      ((string)(!false)),
+      (string)json_encode(true),
+      (false || false ? (34559 & (-51518)) : (int)(0 *	(-8080))),
+    )) &
+      (((int)(-((Class3::CONST0) |
+      ((int)(strlen( "[\"val\"]]")))))))) **	((int)(_safe_int_mod(((int)(-((int)((int)(((int)(-((int)(9284128 - (-62145))))) +
+      ((int)(((int)(_safe_int_div(50727, /**/ /* comment */0))) ** (-255)))))))),  strnatcmp($p2,	$p2,))))))),)) : strcasecmp($p2, $p2)
+      ^ (((!(new Class0())->field3) ? (-6077) : (26831) | ((int)(_safe_int_mod( (ord("49]{\"key\":1}: ''<h1>ok</h1>")),
+      (-57726))))))))]);
+    return tuple(tuple(((int)(255 - (crc32((urlencode( ((string)$v0[ord(/***/'{"key":1}')])))) | ((int)(_safe_int_div((47298), ((int)142.65834579813176))))))), $v0, -255, (sin(380.102643504751))), /* comment */!is_readable($v0), tuple(sizeof(array(
+      strlen($v0),
+      [
+        (true),
+        (!(((int)(-(0))) === 406270525)),
+				Class1::CONST1,
+      ],
+      array(
+        $p1,
+        (21948.293242),
+        (((39.38451673568265) - (_safe_float_div((379072.43532369455), /* comment */(0.05349665014079262)))) + ((((26120) === ("<p>#AC|w" ===
+          $v0 ? (sizeof(array(
+          -255,
+        ))) : (strcmp( '<h1>ok</h1>2=`,', "15")))) ? (((new Class2(-2222.9999, fn() => 'D" 7:'))->field2)) : ((exp(/* comment */-2222.9999) + (atan2($p1,  /***/239.33773520453133))))))),
+        asinh(/**/((new Class2(0.0,  fn() => "wf<div/>;"))->field2) + /* comment */ 0.0)
+			),
+      array(
+        $p3,
+
+      ),
+    )), /**/ $p3, /*
+*/ (strcasecmp($v0, "<div/><p>T%]{$p3}B``j6{$p1}",)  & (Class3::CONST0)), /*
+*/ $v0, $p3, ((int)(_safe_int_mod((((int)(((-255)) + 128412288)) & ((int)(!(!((false) ===	(true ||
+      (!(true && false)))))))), ((int)((-11553) * ((int)(_safe_int_div(ord(((string)$v0[-1])), strcmp($v0, /**/ "{$p3}{$p3}''qpZ#\""))))))))), /***/ $p3, new Class4(/* comment */(((int)((((int)(((!(($this->field3	&& (false)) == $p3)) ? 255 : (-255))	* ((int)crc32( $v0)))))	- ((Class3::CONST0)))) ^ (crc32(Class1::CONST0))),), (is_writeable( ".0x1fU" . "ハロー・ワールド")) && /* comment */ (false)), fn($a7) => $p3,  $p1, function ($a8, $a9, $a10) use ($p3) { // comment
+      return $a10;
+    },  tuple($p1, 0.9575591585963176, $v0,
+      tuple("{$p3}{$v0}-123DD000-123dw9&My",  (-2222.9999), (int)(((int)(-strcmp(/***/('a``Cw%-123'), /*
+*/ "<h1>ok</h1>{\"key\":1}{$v0}0{B-y``q4zF{$v0}-123"))) ** (24158)), (((int)((count(/* comment */array_filter(/***/array(
+      -2222.9999,
+    ),  function ($a11) use ($p3) {
+      return is_finite(2.3308488098141113e+06) && ((-46883) == ((int)(_safe_int_div((-46761),	25841))));
+    }))) + ((int)(_safe_int_mod( ((int)(-(Class3::CONST0))), /*
+*/ 7119105765))))) &	(strcmp($v0, ((Class5::CONST2)  . ("D)/)c24")) . ("0x1f")))), (int)((((int)(((-22532) ^ ((int)((8571) -
+      ((int)(_safe_int_mod(((int)(_safe_int_mod(/* comment */((int)(levenshtein("1((1\n2", /**/ $v0) + ((int)(33346  - (-9284120))))),	/* comment */(ord($v0))))), (Class3::CONST0))))))) -  (((int)(((int)(((int)(-(5589161999))) + ((int)((new Class4(62234))->field11)))) +
+      (sizeof(array(
+      (new Class5())->field10,
+      is_writeable("1\n2{\"key\":1}c=")
+    ),)))) | (-31283))))) +  (((int)(_safe_int_div((Class3::CONST0), 35234))))), $p1), ((!(array_key_exists((string)128412288, array_flip(array_keys(array_keys([
+      "<h1>ok</h1>ok``''",
+    ]))))) ? ((asin($p1) - /*
+*/ (acos($p1))) - (119.24863774466513 + (-1))) : ((floor(313.6153300224776)) -  ((!((((((new Class5())->method6(array(
+      -27814,
+			-55067,
+    ))) && (!true))) && file_exists($v0))  && (is_dir($v0))) ? ((acosh(_safe_float_div(2842.6378, 2.51)) - 405.0822035044245) /* comment */ * ((lib0_func0())  - (Class1::$field3))) : ((array_sum(array_keys([
+      (string)true,
+      (int)(18263 -
+        38278),
+    ]))))))))) - 95914.56747925023,  null,  $p1), ((int)'P/'), $v0);
+  }
+
+
+  /**
+   * @return bool
+   */
+  public function method3() {
+    if (!_visit_function("Class0::method3")) return false;
+    dump_with_pos(__FILE__, __LINE__, /*
+*/ null);
+    try {
+      return (false);
+		} finally {
+      dump_with_pos(__FILE__, __LINE__, /***/"Class0::method3 finally");
+      return false;
+    }
+	}
+
+}
@@ -0,0 +1,422 @@
+<?php
+class Class1 implements Interface0 {
+  const CONST0 = "D";
+  const CONST1 = true;
+  /** @var (?float) */
+  public static $field0;
+  /** @var Interface0 */
+  public $field1 = null;
+  /** @var ((bool[])|float) */
+  protected static $field2;
+  /** @var float */
+  public static $field3;
+	public function __construct() {
+	}
+
+  /**
+   * @param (int|bool) $p0
+   * @param (callable(string,int,string):float[]) $p1
+	 * @param ((bool[])|int) $p2
+	 * @return float
+   */
+  public static function method0($p0, &$p1, $p2) {
+    if (!_visit_function(/**/"Class1::method0",)) return 0.7863266801688145;
+		$v0 = [
+      -1 => /**/ ((((0.6365806154574511) + (-2222.9999)) * (((lib0_func0())) - array_sum([
+        [
+          self::CONST1,
+          !((true /***/ && false) &&  (!false)),
+					(bool)(is_bool($p0,) ? ($p0) : (false ==  false)),
+          is_scalar((int)(128412288 /***/ **	(255))),
+        ],
+        ((new Class5())->field10),
+        (is_writeable(":j") || (((("6v-123\"yYf24R>ハロー・ワールド{\"key\":1}A\000") == ("9''``G9V_G2``+,[\"val\"]"))) && (float_eq3((make_positive_inf()), /**/ 2842.6378))))
+      ]))) * ((make_positive_inf()) + (static::method0((((!(sizeof([
+        -38698 // comment
+			]) === ((int)0))) || (true && (!(is_infinite(make_positive_inf()))))) ||  true) && (is_file((stripslashes(("v"))))), /**/ $p1, [
+        (false) || (is_infinite(2842.6378
+          +	2842.6378) && ((new Class0())->field3))
+      ])))),
+      0
+        => (new Class0())->field2,
+      2 => (472.4976063926166 *
+        0.6521329744876463)
+		];
+    $v1 = new Class4(sizeof( array_filter(array(
+      array(
+        (preg_quote("_''0x1f")),
+      ),
+      "o%424u?-1235_=_#</p>F_4L2[\"val\"]</p>Q\000ハロー・ワールドv",
+    ), function ($a0) use ($p0) {
+      return !(("-123t r}m?Gd|\"p``240000tMc") ==	("ig\\Da%''0x1fcylB,1\n2a <''6?"));
+    })));
+
+    $v1->field0	= /* comment */ $v1;
+    $v1->field0 =
+      $v1;
+
+    return (static::method0( 128412288, $p1, (ord(/* comment */rawurlencode(('/ハロー・ワールド\'\'<div/>' . ("324{\"key\":1}simple string1\n23</p>\000~!}1\n2hr5K0x1fo\000\\tH")) . ((",~..O\000[\"val\"]ハロー・ワールド ]oXMHVS6`") . '~000')),))));
+	}
+
+  /**
+   * @param Class1 $p0
+   * @return callable(int,string):float
+   */
+  public function method1($p0) {
+    if (!_visit_function( "Class1::method1")) return fn($a0, $a1) => 872.6958417498532;
+    /** @var callable():float $v0 */ $v0
+      = function () use (/**/$p0) { //
+      return ((basename("p\"N-123{,A:k000</p>bKQvVr5,``ZX,") /* comment */ ===	(self::CONST0)) ? ((21948.293242)
+        * ((new Class2( (make_negative_inf()), fn() => "\000l"))->field2 +	(52.30185699443599 + 2.51))) : (-2222.9999)) - make_positive_inf();
+    };
+    $v1 =	'0x1f';
+    $v2 = rawurlencode(("\\,0x1fQ ></p>Fハロー・ワールド"));
+    $p0->field1  = new Class6();
+    $v3 = new Class6();
+    return fn($a2, $a3) => array_sum(array(
+      ((is_infinite( (0.16677124750097633))) && (((is_infinite(161.13741650862184)) && (((!false)  || (false)) &&  (is_finite(make_negative_inf(),))))	&& false))
+				&& /***/ (new Class5())->method6( !(!(is_writeable("\000~000b4")))),
+      (!((((!((!false) && (static::CONST1)))) &&	((!(((new Class4(-9284120))->field11) ==
+        ((int)(37084 + 16644)))))) ===  false))  && /**/ true
+    )); // comment
+  }
+
+  /**
+   * @param (int|float) $p0
+   * @param array<string,Class2> $p1
+   * @return int
+   */
+  public function method2($p0, $p1) {
+    if (!_visit_function('Class1::method2')) return 18132;
+    $v0 = tuple((lcfirst(/* comment */"''")), false, (array_key_exists("<p>000000mw[\"val\"][#|Vt6P9D''24J000ou09-123", array_keys([
+      rad2deg((_safe_float_div(4.581580717423838e+06, (false && false ? Class1::$field3 : ((cosh(0.6841581119246171))))))),
+      (sin((float)(is_float($p0) ? $p0 : atan2( 5.7530366012410885e+06, make_positive_inf())))),
+      (float)(is_float( $p0) ? ($p0) : ((2.412837246427537e+06) -	(((float)(is_float(/*
+*/$p0) ? $p0 : (2.51)))  - (((float)(is_float($p0) ? ($p0) : 0.0)))))),
+		])) ? ((static::$field3)) : asin(((new Class0())->field2)) + round((new Class2((4.987024947410044), /***/fn() => ucwords(("! --.w*F [\"val\"]-123S1\n2Q#</p>)^"))))->field2, /*
+*/(int)(sizeof(array_keys(array_map(function ($a0) use ($p1) {
+			return explode("\000",
+         "{$a0}<div/>^2{\"key\":1}", /**/(int)((-12447) + /*
+*/ 12165443802));
+    },  [
+      !(false || false),
+      (Class3::CONST1)
+    ])))))), fn($a1, $a2) => Class3::CONST0, $this, ("simple stringdwha<]ハロー・ワールド{\"key\":1}I#<[\000<div/>&Ycy``*,0x1fIV="),	 new Class1());
+    /** @var callable(int):int $v1 */ $v1 = function ($a3) use ($v0, $p0,  $p1) {
+      return $a3;
+		};
+    $v2 =  -1; //*/
+    /** @var (int|(bool[])) $v3 */ $v3 =	-255;
+    if ((new Class0())->method3()) {
+      throw new Exception2(((string)json_encode((asinh(/*
+*/_safe_float_div(((2842.6378) + 0.0),
+        2842.6378,))),)), -23121);
+    }
+		return 128412288;
+  }
+
+  /**
+   * @param tuple(Class0,callable(string):bool,string,string) $p0
+   * @param (string|bool) $p1
+   * @return Class4
+   */
+  public function method3($p0, $p1) {
+    if (!_visit_function("Class1::method3")) return null;
+    $v0  = new Class6();
+    $v1 = tuple( 0.922974613025751, /***/ "t{\"key\":1}:T|eY)>*!<div/>", !('p&j.\'' /* comment */ == (("EF<p></p>''0<div/>v>oi[\"val\"]-123mO") .  "O l&")), tuple("Uu%{\"key\":1}Jtr</p>>O", tuple((";{")),	((true || (static::CONST1)) || (static::CONST1) ? ((int)(_safe_int_mod( (43697), 734027173))) : (float_eq2((0.00043), (_safe_float_div((((("?N?r~=!K1\n2,x`''''ハロー・ワールド") ==  ((string)(is_string($p1) ? ($p1) : ("<p>"))) ? (354.2491724554626) : (7.539259498305134e+06) + 0.00043)) - (2.51)),  (0.24994997822676326)))) ? (strcmp("-123",   ((":dV\000<V0x1ftC,\"{\"key\":1}bjpqj") . ("RQ000*)X`t,aDsimple string1\n2")) . ((addslashes((basename("->~:3")) . "D9C <div/>z"))))) : ((int)(_safe_int_div((crc32((new Class4((int)((0) ** (1447549894 & 255))))->field4,)),  (24458)))))), cos(57.930855586628674)), /**/((self::CONST1 ? (new Class5())->field10 : (-2222.9999))),	new Class5(), "nN~U0x1f@[\"val\"]",
+      574.7992661432367, (int)(_safe_int_div(((int)(((int)(((int)(_safe_int_mod((strlen(((string)" "))),  ((int)(_safe_int_div(strcasecmp("=C1\n2+E><p>bdK\000?K!xF", ("B`!\000K,\000000 pF0x1f(ハロー・ワールドsハロー・ワールド") .
+      bin2hex("Lx<@}")),	(sizeof(/**/array(
+      [
+        ("<p><Cju"),
+      ],
+      (int)((false ? (13202409327) : 14790) -	((sizeof(array(
+        0.00043,
+        800313025,
+      ))))),
+    ))))))))) - (Class3::CONST0)))	+ ((int)(-(new Class4((int)("2d<div/>3n")))->field11)))), /* comment */((int)(-(!file_exists(((string)(is_string($p1) ? $p1 : (string)170.02140111822357))) ? ((int)(_safe_int_div(/*
+*/(-255), /*
+*/(-1)))) /**/ & ((int)((int)(_safe_int_div(((int)(_safe_int_mod(strnatcmp((",{\"key\":1}5[\"val\"],TU24s,B5h\000S''L]8kg``"), ("uru`,h4TO8{\"key\":1}</p><h1>ok</h1>P")), /***/ ((int)(-25542))))),  (((md5("24")) === (addcslashes('-123ハロー・ワールドzy\\y', "e;Ho",)) ? (((int)(((int)((9284128 ^ 35650) + ((int)(_safe_int_div((-5166),
+			36711))))) -  (8214879564 & ((int)(-(-33506))))))) : ord("8v000\000^1nW`+<l"))))))) : (int)(_safe_int_mod( (strlen("~``z.?,^e<h1>ok</h1>+{\"key\":1}6_{\"key\":1}C8`SN2")),  ((int)(((int)(-(Class3::CONST0))) +	(((int)(((int)(_safe_int_mod( ((int)(false &&
+      true)), /* comment */ ((-20001) ^ (true ? (-255) : (128412288)))))) - strlen("p1``rG1T5y,\000gfGk'simple string",)))
+      &
+      ((int)(_safe_float_div(/* comment */(round(21948.293242, (int)4643) + (new Class5())->field10),  (asinh(/***/329.5,))))))))))))))),	new Class2((0.0 + (0.5574926016053534)),	function () use ($p1, $v0) {
+			return "A<div/><p>[\"val\"]D~)0x1f\000``#``^7simple stringO1F";
+    }), new Class1());
+    if (self::CONST1) {
+      /** @var (?float) $v2 */ $v2 =	((_safe_float_div(261.1951702813648,  (158.65588363094423 /*
+*/ + /* comment */ atan2(/***/(2.51), 21948.293242)))) - ((new Class0())->field2)) + array_sum( array(
+        "ハロー・ワールドt~G\"\"imy<p>_i-123sEw<p>[0003\" :",
+        array_count_values(array_flip( array(
+
+          0.0
+        ))),
+        array(
+          -2222.9999,
+        ),
+        (sinh((make_negative_inf()) + (((1.3522929179102632e+06 - ((false ? 483.90637847931686 : 0.9852918055889681))) - 329.5)))) * (new Class0())->field2
+      ),);
+    }
+    $_iv3 = 0;
+
+    while ($_iv3++ < /* comment */ 1) {
+      switch (chr((int)(((Class3::CONST0) |
+        (-1)) ^ (new Class4(((int)(((int)((((int)((36705) ** 9284128))) - ((int)(((int)(((int)(3146284020 - 9284128)) * 33853)) + (255 & (false ? -23113 : (17187393991)))))))
+        + ((((int)(_safe_int_mod(/***/((int)(-(-9284120))), /**/ ((int)(-(37509 |	(-16699))))))) | (-1)))))))->field11)) . (strrev(strrev((sha1( (rawurldecode(stripslashes("</p>"),)), (array_key_exists((chr((int)(-53963)) . ("6+d[Cp1.<div/>_6I a<h1>ok</h1>wAH\\</p>#<div/>ai 24I;I<<")),  array( //*/
+        (int)((-25408) - 9284128)
+
+      ))))))))) {
+        case ((string)(is_string($p1) ? ($p1) : (" 24B2skb;z :SH&peG1\n2"))):
+          break;
+        case "":
+          break;
+
+        default:
+					$_iv4 = /**/ 0;
+          while ($_iv4++  <	8) {
+            dump_with_pos(__FILE__,	__LINE__, -1);
+          }
+          /** @var callable(string,bool):string $v5 */ $v5 = function ($a3, $a4) use (/***/$v0,
+            /*
+*/$v1, /***/$p1) {
+            return bin2hex(/* comment */(string)'3uEk2');
+          };
+			}
+      $this->field1	= $this;
+      $this->field1 = $this;
+    }
+    return new Class4((int)(-((int)(((int)is_scalar(rtrim((("F") /**/ . (((string)(is_string($p1) ? ($p1) : "%")) . ("nhZ</p>")))))) - ((-61667) ^  ((((int)((((int)(((int)(((int)((strcmp(" \000)",  /**/"simple string"))
+      + (Class3::CONST0))) * ((int)(-(-40616)))))
+      - ((int)("''c</p>%a[\"  Toz,"))))) + ((-49383) & 30857))) |	strlen(("Rhk9R1\n24KyC<h}w0x1f<-123 <p><"))) ^ ord(((string)(is_string($p1) ? ($p1) : (string)(is_string(/*
+*/$p1) ? $p1 : "F^?"))),)))))),);
+  }
+
+  /**
+   * @param tuple((string|Class5),string,float) $p0
+   * @param float $p1
+   * @param (?int) $p2
+   * @param (?float) $p3
+   * @param array<mixed,Interface0> $p4
+   * @param Class6 $p5
+   * @return Class1
+   */
+  public function method4($p0, $p1, $p2, $p3, $p4, $p5) { # comment
+    if (!_visit_function('Class1::method4')) return null;
+    $v0	= "t";
+    $v1  =  (float)$p1;
+    $v2	= (float)(((float_eq2(cosh( (new Class5())->field10), sin((_safe_float_div( 2.51, /* comment */ (0.0))) - (array_key_exists(((string)(-15815)), /**/array(
+      false,
+      ":,''#",
+    )) ? ((0.00043)) : (sinh(2842.6378)	- 329.5)))) ? (float)($p3 ?? (pi() - sqrt((((55.84017293668816 /* comment */ + make_positive_inf()) -
+      0.3865858339841913)
+      - (0.10598415453549133))))) : (array_sum( array_keys([
+			(int)(_safe_int_mod((6042334222),  (Class3::CONST0))),
+    ]),))) - (deg2rad(/**/asinh((((((self::$field3) /*
+*/ * ((0.12778325251888686) - (0.9326683825829052))) /**/ -  ((float)($p3 ?? 2842.6378)))
+			- ((((new Class5())->field10) *  fmod( $v1, 924.7200404491844)))) - /***/ 0.44071117991107983))))) + ((!((!((false) && ((self::CONST1)))) && (!((((false) && (is_finite($v1))) /* comment */ ||	(strnatcmp("simple string",	("''Rd")) == (strcasecmp(("{\"key\":1}"), /*
+*/("M" . '[{v\''))))) &&	((float_eq2(/* comment */$v1,  ceil($v1))))))) ? ((new Class2(((((new Class3())->method0(false, ((int)(-(levenshtein(/*
+*/Class1::CONST0, '["val"]X</w<h1>ok</h1>')))), (int)(((int)($p2 !== null ? ($p2) : (strcasecmp( "%W",  "xu.R`",)))) /*
+*/ * (new Class4(-1))->field11)))) + acos(/***/-2222.9999)),
+      /* comment */function () use ($v1, $p0, $p2) {
+      return basename(Class1::CONST0);
+		}))->field2) : ((2842.6378) + ((asinh(3.0035063100007535e+06) * /* comment */ 0.9158140552753296) - ((Class3::CONST1) ? cosh($v1) : (array_sum([
+      [ //
+        (checkdate(44790,  9284128, 53384)),
+        ((new Class0())->method3()),
+			],
+    ])) - 609.3232835319408))))));
+
+    try {
+      return new Class1();
+    } finally {
+      dump_with_pos(__FILE__, __LINE__, 'Class1::method4 finally');
+    }
+  }
+
+  /**
+   * @param (?Class1) $p0
+   * @return Class0
+   */
+  public function interface0_method0(&$p0) {
+    if (!_visit_function("Class1::interface0_method0")) return null;
+    $v0 = new Class2((-2222.9999) - (((-2222.9999)  + ((lib0_func0()) + (1.439158371041902e+06  +
+      ((new Class5())->field10)))) - (_safe_float_div(/* comment */(0.00043), (1.1464023714296175e+06)))), function () use ($p0) {
+      return "R|<p>LV-123'isn>4Dysimple string!*``L~HUsPC*"; //*/
+    });
+    $v1 = (float)((((float_eq3(329.5, 2.7115287714199936e+06))	|| (new Class0())->field3) ? ((-2222.9999)) : ((0.0))));
+
+    dump_with_pos(__FILE__, __LINE__, 0.7925590062914644);
+    $_iv2 = 0;
+    while ($_iv2++ < 5) {
+			$v3 = new Class5(); # comment
+      try {
+        /** @var callable(string,int,bool):float $v4 */ $v4 = function ($a0, $a1, $a2) use (/***/$p0,
+          $v1, $v0) {
+          return ((!($v0 instanceof Interface0) ? (2.119076779544877e+06) : ((_safe_float_div(292.43913432610907, (2842.6378)))
+						- atan2(/**/((fmod(6.109999684173215e+06, acosh(331.4074539399913 + 1.0459875248186508e+06)))
+            + (-1)), $v1))));
+        };
+        $v5  = $v0;
+        dump_with_pos(__FILE__,	__LINE__, $v1);
+				throw new Exception0(("(hli~o@5-0x1f]5q0x1fCI@&\"<div/>simple string [\"val\"]5"), 128412288);
+      } catch (Exception0 $_iv6) {
+        dump_with_pos(__FILE__, /*
+*/ __LINE__, /**/get_class($_iv6) . ': ' .	$_iv6->getMessage());
+
+      } catch (Exception1 $_iv7) {
+        dump_with_pos(__FILE__,  __LINE__, (get_class($_iv7))	. ": " . $_iv7->getMessage());
+        foreach (array(
+          tuple(21948.293242, new Class0(), /***/ 32929,	"-123", count([
+            array(
+              (false && true),
+              (((file_exists(("^")) || ((Class3::CONST1)	|| /***/ (((false)  || false) || (false || /* comment */ false))))) &&
+                ((is_finite(acos($v1)))	|| (((!false) && (!true)) && (!(true == true))))),
+              Class3::CONST1,
+						),
+            Class5::CONST2,
+            urldecode(/*
+*/']'),
+          ]),  Class3::CONST0,  tuple(656.098901753675, (!(54415	=== ((int)((is_null( $p0) ? (int)(-(((int)(255	+ (-59651)))	& ((int)false))) : 16562186169) * ((int)(-2222.9999)))))) && (((!((new Class0())->method3()))	&& (Class1::CONST1)) &&
+            (!(is_infinite($v1)))), tuple($v1,	"5?a~", /**/((int)(_safe_int_div( ((int)(-((int)(((int)(-50559)) - (strlen(("1\n2e-2`000s`-123 3{$v1}-)bv<p>"))))))), ((int)(count([
+            asinh(199.71075433874202),
+            ("-123V\\S000</p>@ok e0x1f<h1>ok</h1>v4<div/>gi{$v1}"),
+            (false)
+          ])))))), $v1, (560.9331357415443), /***/urldecode( ("o0x1f")),
+            $v1, ("<div/>U</p>k:qi< |v"), $v1,  $v1, (exp($v1)) + (pi())), (ord("Xt0x1fsimple string{$v1}4.``j#</p>/-123>u`> <h1>ok</h1>?=<[\"val\"]{\"key\":1}")),	("=@MR`:gq\000N(1\n2<div/>;uA!=<div/>")), function ($a3, $a4) use ($v0,  $v3, $p0) {
+            return $a4;
+          }, (urldecode("000"))),
+          tuple(_safe_float_div(rad2deg( $v1), ($v0->field2)), new Class0(), (-255) |	((int)(-(5281566926))), 'TK(ハロー・ワールドisimple string', (int)(((true) ? (int)(_safe_int_div(((int)(-((int)(levenshtein( ",*,000''", (("</p>[\"val\"]ph9f``_<div/>") . (("Q{\"key\":1}5") . "S"))) * (-33420))))),
+            4285273917)) : (((int)(ord("2jws24\"yb,p>\000,S<div/>U\"Ri") - count(array(
+            481.92263069909444,
+
+            ((int)(12139309383  - ((int)(-((int)((-255)	** 41415))))))
+					)))))) + strcasecmp(/* comment */((base64_encode("\000 ") . "0-123") .
+						" "), (string)"MC",)), (count(array(
+            array_filter([
+              sha1((bin2hex(/***/"G}Y&x")), (checkdate(-14359,  -26864, -48025))), //
+              "-123" . (("1#'' ''<-123uv<h1>ok</h1>sG1[\"val\"]''^\"") .  ((string)(false && /*
+*/ true))),
+              urlencode((Class1::CONST0)),
+              ucwords(('/')),
+            ], function ($a5) use ( $v0, $v3) {
+              return array_key_exists($a5, [
+                21948.293242,
+              ]) && (!((true ||  true) ||
+                (checkdate( -60026, /* comment */ 6455623438, /***/29726))));
+            }),
+            (6.868788331279172e+06),
+            is_finite(905.409533756931),
+          ))) |	strcasecmp(Class5::CONST2,   ((string)(227.78674765621335))), tuple($v1, (is_nan($v1)) &&
+            (!(($this instanceof Interface0))), tuple($v1,
+            (" #E[5"
+            .	("{$v1}<h1>ok</h1>fH[\"val\"]> ")), levenshtein(/**/"1\n2>PII", (new Class3())->field4), (cosh( (false && ((true) === false) ? (2.950951298432155e+06) : (_safe_float_div((new Class0())->field2, (round($v1, (int)((int)((-45968) /***/ + /***/ (-59362)))))))))),  (0.00043), ("y{\"key\":1}[\"val\"]lO{$v1}2``</p>") .  ':\\V75', (-2222.9999),   '000c!.', $v1, $v1, sqrt($v1)), 255, "-123x"), fn($a6, $a7) => (cosh((4.445307790577351e+06)
+            - 19.80085632630431)), ("o+5!/24l:0x1fQmG)R")),
+
+          tuple( (atan($v1)),  new Class0(),	 (($v3 instanceof Class3) ? (((int)(((int)((52694) ** ((int)(_safe_int_mod((((int)((new Class4(16903128455))->field11  ** /***/ 31328)) ^ ((int)(((int)((new Class0())->field2)) - (((int)(_safe_int_div(9284128, ((int)((-1) - (16666141296)))))) & (-18216))))), (Class3::CONST0)))))) - (strlen("k1\n2/<h1>ok</h1>\000W<div/>Z,4ハロー・ワールドハロー・ワールド{\"key\":1}-123^ b<p>``:OT9K"))))) : (int)(((int)(-((int)(((int)strcmp(("U/|B5" . 'j|-KZ0') . ">&(@", "|dStx000")) ** (255))))) - ((int)(((int)(strcmp("<h1>ok</h1>,RU''</p>A\\'Z@'simple stringU", 'ハロー・ワールド'))) + (((int)(((int)((count(array(
+            ((int)(-(-9475))),
+            (count(/*
+*/array(
+              true,
+            ))),
+          ))) + sizeof(array_flip([
+            '&:[T<h1>ok</h1>',
+            9284128,
+          ])))) - (20017)))))))),	"t{$v1}aVV&''QF<p>_p{\"key\":1}^", -2124, 255, tuple(acosh($v1),
+            ((int)((($v0 instanceof Class6) ? sqrt(/* comment */asin(0.0),)  -  (tan($v1,)) : _safe_float_div((array_sum([
+            make_negative_inf(),
+						-1151,
+          ])), (0.6626210230248721))) * ((make_negative_inf()) - $v3->field10))) ===  count([ //*/
+            128412288, //
+          ]),  tuple($v1, /***/'a.2',  (int)(_safe_int_mod(count(array_flip( array(
+            (329.5),
+            [
+							cos(216.8460453602424,),
+              (21.756098160589058),
+            ], //*/
+          ))), /**/((int)((int)(-strcasecmp("000242{2\000tw", ">O1\n2v04\\<h1>ok</h1>;0x1f(J`000``1\n2[\"val\"]{$v1}")))))), /*
+*/cos(sin(0.00043)), /*
+*/(0.7122155202043156),
+            /**/("3\\{\"key\":1}B[lL</p>5P%O;552-123{$v1}") . (addslashes((strtoupper("<div/>")))), (round(704.3532885413936, (int)((int)(((!((!true) || is_scalar(0.5521974022612421))) ? (3650) : strlen('Emc=\'\'')) +  9284128)))), strtoupper(/**/(htmlentities('B>\'#t',)),), $v1, (0.00043), $v1,), /*
+*/ (int)((crc32(("hqVsimple string&w+{$v1}{\"key\":1}<p>#d<p>.K"))) + (crc32((bin2hex(/**/",c<div/>v2@LH<p>}26"))))),  'e6:Ch'), fn($a9, $a10) => $a10, htmlentities(addslashes(/* comment */('-123')))),
+          tuple($v1, new Class0(), 9284128, "-123:<qV8LP-T@mK[)", (strlen(/***/"90000x1f5:Vw000e!0>c@''X=q{$v1}``x")), (0), tuple( 0.5484612598657197, true, /* comment */ tuple( ((new Class5())->method6(array_count_values(array_keys([
+            "1\n2<h1>ok</h1>", //
+            false
+					]))) ? (318.57205473902286)
+            - (_safe_float_div(25.838906969954948, 2.6886967139283046e+06)) : ((($v3->field10) +  (1.7877285615544838e+06)))) +
+            sin(0.00043), Class5::CONST2, count(array(
+            (sizeof(array_keys([
+              'L?R',
+            ]))),
+					)), ((0.47149480115286724 + ((((!(($v3 instanceof Class3)))
+            || /* comment */ (checkdate(-9284120, /* comment */50135, 40834) && (false
+            && true))) || ((false) || (false))) ? (0.00043) : (0.0))) - $v0->field2), ($v0->field2), ("d|<h1>ok</h1>z{$v1}24){<h1>ok</h1>9X"), $v1, ('V'),
+             (ceil(7.116958249821839e+06)),  (new Class5())->field10, /* comment */ $v1,),  (strnatcmp("(TXk[\"val\"]Id``", (dirname(/*
+*/"sM[\"val\"]N>Sx*vh0x1f|C<h1>ok</h1>ハロー・ワールド5R")))),
+            lcfirst((ucwords(":")))), fn($a12, $a13) => 12.655359263982817, "0x1fu<qjMA]L<p>-123n88msimple stringD")
+        ) as [$v8, $v9, $v10, $v11, $v12, $v13, $v14, $v15, $v16]) {
+          dump_with_pos(/***/__FILE__,
+            __LINE__,
+            $v8);
+          dump_with_pos(/*
+*/__FILE__, __LINE__, $v10);
+          dump_with_pos(__FILE__,  __LINE__, $v11);
+          dump_with_pos( __FILE__,	__LINE__, $v12);
+          dump_with_pos(__FILE__, /*
+*/ /*
+*/__LINE__, $v13);
+          dump_with_pos(__FILE__, __LINE__,  $v16);
+
+        }
+			}
+    }
+    $p0 =  (is_null($p0) ? (new Class1()) : ($p0));
+    return new Class0();
+	}
+
+  /**
+   * @param Interface1 $p0
+	 * @param bool $p1
+   * @return (bool|float)
+   */
+  public function interface0_method1(&$p0, $p1) {
+    if (!_visit_function('Class1::interface0_method1')) return false;
+    /** @var callable(string,bool,int):bool $v0 */ $v0
+      =
+			function ($a0, $a1, $a2) use ($p0,  $p1) {
+      return Class1::CONST1;
+    };
+    [, $v1, $v2, $v3, $v4, $v5, $v6, $v7, $v8] =	tuple( tuple((int)(-((int)(-(128412288 & ((((int)(_safe_int_mod( ((int)(((int)(((int)(-(-39414))) **
+      ((-255) ^ 0))) ** (((int)((4531) ** (40380)))))), ((int)(-strcasecmp("jG/ハロー・ワールドd", "Mf|")))))) | (Class3::CONST0)) & ((("{$p1}X7s]m''XC<div/>{$p1}mAcs?<") == ('n)' . ("v1\n2t"))) ? (((new Class4(strnatcmp('6 m\\', "!\000'")))->field11)) : (levenshtein('<h1>ok</h1>s', (long2ip((int)2428775213)) . "zP,")))))))), (24.154093703371434), str_split( ")u",
+      ((int)(((int)(_safe_int_mod(4037483601, (((-252)) & ((int)(-((int)((Class3::CONST0) /*
+*/ - ((int)((((int)(34185 ** 682317470)) |  ((int)((-9284120)	** /* comment */ (-1)))) + (Class3::CONST0))))))))))) * (ord(("''''t``XPz000TbuT``0000x1f{$p1}{_U!9*y''z")))))),  "0r31[\"val\"]K&ZD= Pハロー・ワールド``", /*
+*/ fn() => strlen(("O`24{\"key\":1}ハロー・ワールド")), $p1, $p1, Class3::CONST1,  ('N)iMF000')),  !(false || (!('{["val"]\'\' <h1>ok</h1>h' == ("ハロー・ワールド2Q4K|<h1>ok</h1>-1239")))), (int)((new Class0())->field3), make_nan(),  "simple stringD4[\"val\"] f", new Class2( _safe_float_div(/**/(-1), (is_writeable("<p>>H<div/></p>q={\"key\":1}simple stringD,Mi") ? (749.0138942556812) : ((0.00043) - (-2222.9999)))), function () use ($p1, $v0) {
+      return "hdH90x1f";
+    }),  [
+      is_readable(/*
+*/"``:*-]") ||
+        (!is_finite(-1)),
+      $p1,
+      $p1,
+      is_readable(/* comment */implode((Class1::CONST0),  [
+        "\000#''",
+        ",E''t2%''sN000",
+        Class5::CONST2
+      ])),
+		], new Class1(),	"/.[");
+    dump_with_pos(__FILE__,  __LINE__, $v1,);
+    dump_with_pos(__FILE__, __LINE__, $v2,);
+    dump_with_pos(__FILE__,	__LINE__, $v3);
+		dump_with_pos(__FILE__, __LINE__, /**/$v4);
+    dump_with_pos(__FILE__, __LINE__, $v6);
+    dump_with_pos(__FILE__, __LINE__,
+      $v8);
+    usort($v6, function ($a, $b) {
+      return $b <=> $a;
+    });
+    dump_with_pos( __FILE__, __LINE__, /* comment */$v6);
+    if ($v2 == $v2) {
+      throw new Exception2($v8, -9284120);
+    }
+    return _safe_float_div(((new Class0())->field2), 21948.293242);
+  }
+
+}
@@ -0,0 +1,277 @@
+<?php
+class Class2 implements Interface0 {
+  /** @var (?(int[])) */
+  private $field0 = null;
+  /** @var int */
+  protected $field1 = -6177;
+  /** @var float */
+  public $field2;
+  /** @var callable():string */
+  protected $field3;
+  /** @var Class6 */
+  public $field4 = null;
+  /**
+   * @param float $field2
+   * @param callable():string $field3
+   */
+  public function __construct($field2, $field3) {
+    $this->field2 /**/ = $field2;
+    $this->field3 = $field3;
+  }
+
+  /**
+   * @param callable():int $p0
+   * @param int $p1
+   * @param int $p2
+   * @param float $p3
+   * @param Class4 $p4
+   * @param (Class4[]) $p5
+   * @return int
+   */
+  public function method0($p0, $p1, $p2, $p3, &$p4, $p5) {
+    if (!_visit_function('Class2::method0')) return -33371;
+    {
+      ['-0' /**/ => $v0] = [ # comment
+        "-0" => array(
+          (int)(((int)((((int)(((int)((9284128) + (strcmp(htmlentities("t"), (Class1::CONST0))))) /**/ **	((int)(-1)))) ^ ((int)("_|{\"key\":1}a+ iY0x1fA^5g-1\n2<div/>")))  * ((int)(levenshtein(urlencode(("0x1f<div/>j{\"key\":1}``!000<h1>ok</h1>!wF`{\"key\":1}<p>\\24Mn,U")), (("D_''h{$p2}i'|>aj\"0x1f#zハロー・ワールド") . ("qn``0}#Co66N7M y\000EbM1{$p2}000")))  - ((int)(((int)(((((int)((((int)(_safe_int_mod((-1), /**/34054,))) /*
+*/ | (-13239)) & count([
+            '000-123)',
+          ]))) |	strlen(("og)+ZC~a</p>")))) *
+            ((int)(-19133350019)))) * ((int)((3419060935 & ((int)(-strcmp(urldecode("/R"), /***/ /*
+*/"h</p>D<p>fz")))) * 1159)))))))) ** (-1)),
+          ((int)(-((int)(-((int)(_safe_int_div((((int)(((int)((Class3::CONST0) + (-1428))) + ((int)(-((int)(_safe_int_mod(((int)(crc32('*Y_') + (((int)((((int)(_safe_int_mod(15950552467, 128412288)))) + ((false ? (-42556) : -1))))))), 44210))))))) & ((int)(-((int)(make_negative_inf()))))), 11759465534))))))),
+          ((int)(-((int)(((int)floor(/**/$p3)) + ((int)((sizeof( [
+            (new Class5())->method6(Class3::CONST1),
+            array(
+              (!(false)),
+              !($p4 instanceof Class3),
+              (!(!(float_eq3(21948.293242, $p3)))),
+            ),
+          ]))
+            + (sizeof(array(
+            (new Class0())->field3,
+            ("{$p2}{$p3}&l<h1>ok</h1>0x1f000") # comment
+          )))))))))
+
+        ),
+      ];
+      dump_with_pos(__FILE__, __LINE__, $v0);
+    }
+    $v1 = array(
+      1	=> 2842.6378
+
+    );
+    $p4->field7	= deg2rad($p3); //*/
+		return 18557352372;
+  }
+
+  /**
+   * @return (?(bool[]))
+   */
+  public function method1() {
+
+    if (!_visit_function('Class2::method1')) return null;
+    dump_with_pos( __FILE__,  __LINE__, array_filter([
+      sha1((new Class3())->field4),
+    ], /* comment */fn($a0) => is_nan((rad2deg((((new Class2(216.36404727335284,
+      function () use ($a0) {
+      return $a0;
+    }))->field2) * (((0.3067563927819121) /*
+*/ - /* comment */ ((acosh(2.51)) -  (0.28846704690012126))))),)) + (329.5 - (make_negative_inf())))));
+    if (true === is_readable(("c,F."))) {
+      /** @var (?(bool[])) $v0 */ $v0 = [
+        Class1::CONST1
+      ];
+
+    }
+    dump_with_pos(__FILE__, __LINE__, 989233275);
+    return array(
+      is_file((string)((string)acos((2.51),)))
+        && ((!(true	|| (Class1::CONST1)))),
+      ((((true) /* comment */ || (true == (!(((!(false || false)) || false) || ((false  && (is_finite(2842.6378,) ||
+        (!true))))))))  || is_dir(("\000tT)z-\\<<p>Aze:NE"))) &&	(false	&&  true)) || (Class1::CONST1),
+      (!((Class1::CONST0) === ((string)true))) ||	((false))
+    );
+  } //
+
+  /**
+   * @param float $p0
+   * @param Class6 $p1
+   * @param Class6 $p2
+   * @param (string[]) $p3
+   * @param (?int) $p4
+   * @param (?(float[])) $p5
+	 * @return callable(int,int,string):float
+   */
+  public function method2($p0, $p1, $p2, $p3, $p4, $p5) {
+    if (!_visit_function("Class2::method2")) return fn($a0, $a1, $a2) => 0.09557446654814572;
+    $p2->field4 = $p1;
+    $p1->field4 = /**/ $p2;
+		return function ($a3, $a4, $a5) use ($p4, $p1) {
+
+      return (new Class0())->field2;
+		};
+  }
+
+  /**
+   * @param callable():string $p0
+   * @param Interface0 $p1
+   * @param int $p2
+   * @param float $p3
+   * @return (bool|Class6)
+   */
+  public function method3($p0, $p1, $p2, $p3) {
+
+    if (!_visit_function("Class2::method3")) return true;
+    $_iv0 = 0;
+    while ($_iv0++ <  1) {
+      /** @var (int|bool) $v1 */ $v1 = Class3::CONST0;
+    }
+    /** @var (?string) $v2 */ $v2 /***/ = "oP,"; # comment
+    try {
+
+      return new Class6();
+    } catch (Exception0 $_iv3) {
+      dump_with_pos(__FILE__, __LINE__, /**/ get_class($_iv3) .	": " . $_iv3->getMessage()); //*/
+      return (true);
+    } finally {
+      dump_with_pos(__FILE__, __LINE__, "Class2::method3 finally");
+      return new Class6();
+		}
+  }
+
+  /**
+   * @param Class5 $p0
+   * @param (int|(float[])) $p1
+   * @param float $p2
+   * @param Class6 $p3
+   * @return (?(string[]))
+   */
+  public function method4($p0, &$p1, $p2, $p3) {
+		if (!_visit_function("Class2::method4")) return [
+      "\000r1",
+      "\"Ssimple stringb",
+    ];
+    $v0 = array(
+      (is_finite(sinh((make_positive_inf())))),
+    );
+    /** @var (string|(float[])) $v1 */ $v1
+      = [
+      (new Class2( $p2, fn() => ("v24\000<p>")))->field2,
+      0.48071281300186275,
+      665224.0840977798,
+      $p2
+    ];
+    if (float_eq3($p2, $p2)) {
+      foreach ($v0 as &$v2) {
+        $v2	= (17500.019657782366);
+      }
+      unset($v2);
+      foreach ($v0 as $v2) {
+				dump_with_pos(__FILE__, __LINE__, $v2);
+      }
+      dump_with_pos(__FILE__, __LINE__,  $v0);
+    }
+    $v3 = array(
+      new Class3(),
+    );
+    $p1 = $p1;
+    return str_split((ltrim(substr_replace((("0x1f")	. /***/ "</p>hpA"),	strrev(((string)(is_string($v1) ? $v1 : ('o{"key":1}X</p>~' . ("F*L"))))),	(int)((int)(-(ord((Class1::CONST0))))), (int)((!is_writeable(("_<p>ut{L"))) ? strcasecmp((new Class3())->field4, /*
+*/ ('[')) : ((int)dirname("simple string-''6<p>M{$p2}[\"val\"]")))) . ("e"), (Class1::CONST0) . (gettype(strlen((string)(is_string($v1) ? $v1 : "<div/>R@J{,"))) . md5((string)(is_string($v1) ? $v1 : (("``1\n2?/,</p>K"))),
+      !(("-123{$p2}@[5w''ハロー・ワールドsF") == /* comment */ htmlentities("@")))))), (9284128),);
+  }
+
+  /**
+   * @param (?Class1) $p0
+   * @return Class0
+   */
+  public function interface0_method0(&$p0) {
+    if (!_visit_function('Class2::interface0_method0')) return null;
+    /** @var bool $v0 */ $v0 = file_exists( ((string)(-30106)));
+    return new Class0();
+
+  } //*/
+
+
+  /**
+   * @param Interface1 $p0
+   * @param bool $p1
+   * @return (bool|float)
+   */
+  public function interface0_method1(&$p0, $p1) {
+    if (!_visit_function('Class2::interface0_method1')) return false;
+		$v0 = new Class1();
+    foreach ([
+			tuple(array(
+        (false || (is_nan((_safe_float_div((asin(0.00043 - 138.9859254665171)	- (!(!false) ? (397.52149205201573) : make_positive_inf())), /* comment */(new Class0())->field2))))),
+				(true), # comment
+        is_readable(("{$p1}:^\000z@50x1fvw]")),
+        file_exists(("_7()''`u''|_8simple stringKA%``:dV-123s</p>m5/\"T~")),
+      ), ("</p>F+|o6=_S? ハロー・ワールドt[\"val\"]_(,{$p1}:{\"key\":1}Wm"),	/**/(new Class3())->method0(true,  (int)(((int)(_safe_int_mod(((int)(sizeof([
+        58264,
+        "<*<div/>H",
+      ]) ** (((int)(((int)(levenshtein('5P0x1f', 'l3.^-123',) - ((int)(4128 **
+        9284128))))	+  (sizeof(array(
+        false, //*/
+      )) ^ ((int)((-16575) + /**/ 9284128)))))))),
+        (-24342)))) - (levenshtein(("000> {\"key\":1}ハロー・ワールド{$p1},RPB''-123."), '"',))), /**/ /*
+*/(levenshtein((htmlentities((string)(false && false))), ("-123`1\n2``n|?a;p#{\"key\":1}59W'n</p>px0x1f&yZ]ul''*"))))	- ((((acosh( pi())) /**/ - ((acos(exp(94.43447328891358))) - ((true && array_key_exists("`/000000",  array(
+        765.7272751170016,
+        true,
+      )) ? ((0.9111888988409698 - /*
+*/ array_sum([
+        9284128,
+        -9284120,
+      ]))) : ((0.00043	- 620318.6030203032)))))) + ((_safe_float_div(/*
+*/(tan(/**/(acosh(-1))) * (_safe_float_div((((new Class5())->field10 +  atan(0.10808160497423476)) - (lib0_func0())), fmod(make_nan(),	/* comment */(new Class2(0.0, /*
+*/fn() => "-123"))->field2)))),  (829.6646478741214
+        +	((6.515726285875739 -	(79.43698017478535 - 0.9430219890883006)) +
+        (cosh(-2222.9999)))))))) + ((395.2952994521431))), /**/ -47025, new Class1())
+    ] as [, $v1, $v2, , $v3]) {
+      dump_with_pos(/***/__FILE__,  __LINE__, $v1);
+      dump_with_pos(__FILE__, /**/__LINE__,	$v2,);
+      dump_with_pos(/* comment */__FILE__, /* comment */ __LINE__,  $v2,);
+      $_iv4 = 0; # comment
+      while ($_iv4++  < 1) {
+        $v5 =  new Class6();
+        $v5 =  new Class1();
+      }
+    }
+		$v6  = (int)((int)(((int)(((int)(_safe_int_mod(((int)checkdate((int)(-((int)(_safe_int_div(41957, ((int)((-18561) * (255))))))), (int)((0  ^	((int)(((new Class4(4447245332))->field11) - ((int)(((int)(-255)) *	(Class3::CONST0)))))) +
+      (strcmp(ucwords("\\000n{L["), ('zi')))), 10374)), ((int)((((int)(((int)(60445 - ((int)((Class3::CONST0)  - (((int)(-((int)(-(-12900)))))  ^ ((((strcmp( 'ハロー・ワールド', "Ek``;YC")) /**/ & /*
+*/ ((true ? (40626) : (10941948984))))) ^ ((is_readable("2a5i<p>V")) ? ((int)((-55149) ** ((-255)))) : count([
+      make_nan(),
+      0.25399431797107314,
+    ],)))))))) + ((-16134))))) /* comment */ -
+      ((int)27630))))))
+      ** 255)) + (new Class4(/**/(17680798947)))->field11));
+    switch (47.636906840946345) {
+      case 652.8872372047149:
+        list($v7, $v8, $v9, $v10) = array(
+          fn($a1, $a2) => $p1,
+          function ($a3, $a4) use ($p0, /* comment */  $p1) {
+            return $p1;
+					}, # comment
+          function ($a5, $a6) use (/*
+*/$v6, $v0,  $p1) {
+            return $p1;
+          },
+          function ($a7, $a8) use ( $v6, $p0, $p1) {
+            return $p1;
+          },
+        );
+        dump_with_pos(__FILE__, __LINE__, 0.9002474362867008);
+        break;
+      case (make_positive_inf()):
+      case 139511.7021836775:
+        break; # comment
+      case 150.82578725848143:
+
+        break;
+    }
+
+    return (false);
+  } //
+
+
+}
@@ -0,0 +1,273 @@
+<?php
+class Class3 {
+  const CONST0 = 12982466552;
+  const CONST1 = true;
+  /** @var Class4 */
+  public $field0 = null;
+  /** @var callable(string,string):string */
+  protected $field1;
+  /** @var Class5 */
+  public $field2 = null;
+  /** @var (?(string[])) */
+  private $field3 = null;
+  /** @var string */
+  public $field4 = '<p>{~!`simple string'; // comment
+  /** @var (?Class5) */
+  protected static $field5 = null;
+  /** @var (?bool) */
+  public static $field6 = null;
+  /** @var (?float) */
+  public $field7;
+  /**
+   * @param bool $p0
+   * @param int $p1
+   * @param int $p2
+   * @return float
+   */
+  public function method0($p0, $p1, $p2) {
+    if (!_visit_function(/***/"Class3::method0")) return 910.4164095666251;
+    /** @var (?int) $v0 */ $v0 = levenshtein((("simple string''C24/c [\"val\"]\000x!") . ltrim((((string)json_encode((new Class0())->field3)) . /*
+*/ "simple string"),  "<div/>aj''<div/><div/>m2B''H{l-123{$p0}")), Class1::CONST0);
+    /** @var (bool|int) $v1 */ $v1 /**/ = strnatcmp((Class5::CONST2) . ((trim((md5(/***/":{\"key\":1}E6'0")) . ("O``%<div/>ハロー・ワールド|<div/>U-\000j+a"), ("&i<p>?7EBq"))) .
+      "D>"), "{$p2}#~{\"key\":1}U{$p0}{$p0})P[\"val\"]<p>e",);
+    $v2 =  new Class5();
+    try {
+      return 0.5373587473535636;
+    } catch (Exception2 $_iv3) {
+
+      dump_with_pos(__FILE__, __LINE__, get_class($_iv3) . ": " . $_iv3->getMessage());
+      return 2.51	+	((0.970012028973373 - (new Class5())->field10) - 0.0);
+    }
+  }
+
+  /**
+   * @param Class4 $p0
+   * @param (?string) $p1
+   * @param callable(bool):float $p2
+   * @param tuple(tuple(int,float,callable():bool,bool,string,string),bool,string,(?(bool[])),float,Class0,string,tuple((?string))) $p3
+   * @param callable(int,bool):string $p4
+   * @param tuple((?bool)) $p5
+	 * @return int
+   */
+  public function method1($p0, $p1, $p2, &$p3, $p4, $p5) {
+		if (!_visit_function('Class3::method1')) return 2419050103;
+    $v0 /*
+*/ = array(
+      new Class6(),
+      new Class1(),
+      new Class2(0.0,  fn() => (lcfirst(/* comment */("simple stringBd")))),
+      new Class1()
+    );
+    /** @var callable(float,int,int):bool $v1 */ $v1 = function ($a0, $a1, $a2) use ($p0, $p4, $v0) {
+      return (false); //
+    };
+    $p3 = tuple(tuple(-41793,	_safe_float_div((Class1::$field3), 2.51),  fn() => ((new Class0())->field3 && /***/ (!((static::CONST1) || (($v1((0.00043  - 0.0),	(strnatcmp('["val"]\'\'<div/>_', "0x1f\000<div/>J%",)),  (sizeof(array(
+      -15358,
+      1.1575921645344342e+06
+    ))))
+      || (!(new Class0())->field3)) ||
+      is_writeable((Class5::CONST2)))))), (!(is_finite((_safe_float_div(fmod((sinh(132.1919781562747)),  ((new Class0())->field3 ? make_positive_inf() : (deg2rad(make_positive_inf())))),  (2.2758423706916575e+06))) + ($p2(/***/(((true || true) ||	(Class3::CONST1)) &&
+      true)))))), "c{d\000000:", (rawurldecode("{\"key\":1}31\n29V</p><h1>ok</h1>6+24*"))), true,	((string)($p1 !== null ? $p1 : ("6-123z "))),	array(
+      (new Class0())->method3(),
+      true,
+
+      (false),
+      (checkdate((int)(((int)(((int)(((strlen( (new Class3())->field4)) /*
+*/ ^ ((new Class3())->method1($p0, $p1,  fn($a3) => 21948.293242,
+        $p3, $p4, $p5))) - (new Class3())->method1(new Class4(9284128,), $p1, $p2, /*
+*/ $p3, function ($a4, $a5) use ( $p1,
+        $p0, $v1) { //*/
+        return ("ye{$a4}{$a5}");
+
+      }, $p5))) - (-43900))) + ((int)(_safe_int_mod(/***/((int)(true)), ((int)((255) ** ((int)($p0->field11 - 9284128)))))))), strlen(((string)($p1  ?? ($p4( ((int)(_safe_int_mod(/**/(-13864), /***/0))), !false,))))), ((int)preg_quote((string)(is_null($p1) ? ("241[-123{M_2<p>X`-123).^^2") : $p1))))),
+		), /***/  (deg2rad((make_positive_inf())) * $p2( (is_file( ((string)(Class1::CONST1)) . ((string)(is_null($p1) ? ("[\"val\"]Hvn1|Y cDz\\dsimple stringJjL0ZR") : $p1)))))),  new Class0(), "\000<h1>ok</h1>{\"key\":1}BtO", tuple(long2ip((int)9284128)));
+		return 26511;
+  }
+ //
+  /**
+   * @param (float|bool) $p0
+   * @param tuple(int,((string[])|Interface0),float,callable():bool,tuple(tuple(int,string,bool,float,bool,bool,int,int,string),float,array<mixed,int>,string,string,bool,float,bool,float),int,Class0,bool,(string|Interface0),int,(?string),tuple(int,bool,callable(int):float,float)) $p1
+   * @param int $p2
+   * @param int $p3
+   * @param tuple(Class4,bool,string,float,Class1) $p4
+   * @return (array<mixed,(Class2|(bool[]))>[])
+	 */
+  public function method2(&$p0, $p1, $p2, $p3, &$p4) {
+    if (!_visit_function("Class3::method2")) return array(
+			array( # comment
+        -9284120 => null,
+				"1e3" => array(
+          false,
+          false,
+          true,
+          false,
+        ),
+        "2" => null,
+        "1" => [
+          false,
+          false,
+          false,
+        ],
+      ),
+      array(
+				-1	=> [
+          false,
+          false,
+        ],
+
+        "" => null,
+        '1e3' /* comment */ =>  [
+          true
+        ],
+        4 =>  [
+
+          false,
+          true, //
+        ]
+      ),
+    );
+    /** @var (string|Class6) $v0 */ $v0 = strtoupper("{$p3}simple string]@{$p3} ''\000 'T)U %[\"val\"]0jw{\"key\":1}-123{$p3}");
+    /** @var (bool|float) $v1 */ $v1
+      = true;
+    dump_with_pos(__FILE__, __LINE__, /* comment */[ # comment
+      "#Wu6<p>.jE<h1>ok</h1>000{\"key\":1}",
+    ]);
+    $p0 = (!(is_readable((((stripslashes('</p>') . /* comment */ ("249)ROcl0x``Jd<div/>MG<p>5>}2)``\00024^u-123,{$p3}A"))) . ((string)json_encode(("&{$p3}>mwJU ,D24O<div/>"))))) &&
+      (!((preg_quote(Class1::CONST0, /* comment */ (" ?{$p3}{$p3}{$p3}"))) /* comment */ === (((string)(is_string( $v0) ? $v0 : (string)(strcmp("v1\n2Xx`", "[\"val\"]")))) .
+      ((string)(is_string($v0) ? ($v0) : ((urlencode((gettype(0.984585259884247))))))))))) ? ((float)(is_float($v1) ? ($v1) : ((29.061348375323778)))) : ((((("mGE" .
+			("X0x1f</p><p>9{$p3}<p>h{O{$p3}")) . ("``,{$p3}`` 4-")) == ((Class1::CONST0) . ("O~;Yx0x1f<h1>ok</h1></p>)k\000{$p3}"))) ? (((((-2222.9999) /**/ - (Class1::$field3)))	- (atan2((4.077526402643472e+06),  fmod((make_nan()), (sin(asinh(-1)) - (2.51
+      - round(97.93389116593806)))))))) : 329.5)));
+    return [
+      array(
+				0 => new Class2(0.0
+          + (tan((_safe_float_div( (_safe_float_div(((756.4053094826943) + 0.6624878029617012),
+          /***/(round(0.0 + 2842.6378, (int)((int)34805))))),	((new Class2(642.8166069187034, fn() => "{\"key\":1}simple string[\"val\"]0x1fハロー・ワールドC"))->field2
+          - sinh(0.9910879671173594)))))), function () use ($p0,
+           $p4, /***/ $p1) {
+          return '..gRH</p>';
+        }),
+      ),
+      [
+        "0" => new Class2(cosh(0.0), function () use ($p2, $p3, $v1) {
+          return '</p>``TP';
+        },),
+      ],
+      array(
+        "1" => array(
+          false,
+          (!file_exists("1\n2D<h1>ok</h1></p>&;X000 bs",)),
+          levenshtein(" @UM0x1f <div/>6?{\"key\":1}6A\\mLCq''ハロー・ワールド0x1fxba(j&3",  ((string)(is_string($v0) ? ($v0) : (Class1::CONST0)))) == ((int)((0) - ((int)(((int)((self::CONST0) **	sizeof([
+            (2.0851060212024155),
+            0.00043 + 21948.293242,
+					]))) -	((-61141) | strlen( (sha1("\000cvj")))))))),
+          (new Class0())->field3,
+        ),
+        "-1" => /**/ array(
+					((float_eq2(329.5, /* comment */(0.1267427956352022)))	|| ((new Class0())->field3))  && ((!(is_bool($v1)))
+            ||
+            ((self::CONST1)
+            && (!((Class1::CONST1) || (((((bool)(is_bool($v1) ? ($v1) : false)) /**/ && (!true))) && (!($this instanceof Class4))))))),
+          (self::CONST1),
+				),
+        '1 '  => array(
+          (is_nan( (cosh( 260.4888878189536)))) && checkdate((int)(-(strcmp(Class1::CONST0,  "[\"val\"]" . " ") ^ /**/ strnatcmp( htmlentities(/***/""),  ((string)(is_string($v0) ? $v0 : ("hpTD")))))),  $p3, /**/(levenshtein('cl~"',  "simple string\\" . "o")),),
+          ((((bool)(is_bool($v1) ? $v1 : true)) || (!((is_infinite(/* comment */2.577382985287717e+06,) && (!(is_dir("8/dNn")))) && (false ||
+            ((!false) /***/ ||
+            ((bool)(is_bool($p0) ? ($p0) : (false)))))))) &&
+            is_writeable( (((string)(_safe_float_div((0.0 - (110.57821805615293)), 1.0773569684476533e+06)))
+            . (new Class3())->field4)))	||  false,
+        ),
+      ),
+    ];
+
+  }
+
+  /**
+   * @param tuple(tuple((string[]),float),float,bool,array<string,Class3>,float,bool,Class3) $p0
+   * @param array<mixed,(string|(bool[]))> $p1
+   * @param (string[]) $p2
+   * @param tuple(float,bool,tuple(int,Class6,tuple(int,bool,int,bool,int,bool,string,float,bool,bool,int,int),float,float),float,int,(bool|(bool[])),float,bool,int,float) $p3
+   * @return (int[])
+   */
+  public static function method3($p0, $p1, $p2, $p3) {
+    if (!_visit_function("Class3::method3")) return [
+      -9284120,
+      9284128
+    ]; // comment
+    $v0
+      = /* comment */ (float)(0.05899872492219374);
+    dump_with_pos( __FILE__, __LINE__, array_count_values(array_filter(array_flip(array_keys(array_flip(array_keys(/*
+*/array_filter(array_filter(array(
+      make_negative_inf(),
+			array(
+        ("0x1fVX80x1fw<div/>"),
+				"<div/>\"7B{$v0}\000",
+      ),
+    ), function ($a0) use ($p3, $p2) {
+      return false;
+    },), fn($a1) => (is_nan((tan(200.90236024085212 -  334.7595262491639)))))))),), fn($a2) => (static::CONST1)),));
+    return Class3::method3(tuple(tuple(/***/array(
+      (string)0.6880144163835265, // comment
+      "0x1f_w000h<h1>ok</h1>2|> Y3:/2uVQ9Y</p>/[\"val\"]'c'"
+    ), _safe_float_div( (Class1::$field3),  (_safe_float_div(((((((!true) || (!false) ? 0.5544554148944056 : (acosh(1.1536754697810144e+06)))) + cosh((0.8231847482067037 +  (329.5))))	+ (atan( (8.709258550805598e+06)) - (2.51))) -
+      (sqrt($v0))), (2.51 + rad2deg($v0)))))), make_nan(), /**/true, array(
+      "key" => new Class3(),
+      "a" =>	new Class4(count(array_flip(array(
+        (("e 1\n2d'' B28") . '-123)1X') . (((("I2ys-123<div/>") .	"X[\"val\"]AOs"))
+          .
+          (","  . "Wq{\"key\":1}=>")),
+        is_finite(329.5), //*/
+        "{$v0}-123ハロー・ワールドnP^",
+        (true &&  (!false)) === (!(true &&  true)),
+      )))),
+
+    ), $v0, true,  new Class3()),  array( //
+      " 1"	=> (":F[nG{$v0}{B5Kj"),
+    ), explode('r], \\',
+      /***/((string)true) . ("u 24|jEkif")), tuple($v0, true, tuple(strcmp("KA<p>thM*%Xr;{$v0}w{o{$v0}b*PXE\000<div/>,}SW7",
+			(string)is_dir((string)("Qr[5n))''-123 zStvK:6U[z,m,5-123")),),
+      new Class6(), tuple((-29562), (true && (Class1::CONST1)), (((int)((-255) ** ((int)(-26586)))) ^ (-6506)), ((is_readable(((new Class3())->field4))) && ((new Class0())->method3())) || false, /**/(new Class4(128412288))->field11,
+      true, rawurldecode((Class5::CONST2)),
+      $v0, /*
+*/ false, true, (int)(Class1::CONST0),
+       ((int)(-((int)(((strnatcmp("\000", ("-123")))) - ((int)(_safe_int_mod(((int)(-strlen( "dm{D" . '{"key":1}'))), ((int)(((is_finite(0.00043,) ? ((int)(29214 ^ 9284128)) : (int)(((int)(9284128	+  (-255))) * strnatcmp(/*
+*/'!@{Z<p>',  "- Pt"))))	- ((int)(count(array(
+      "!",
+			'_);V'
+    )) + 29635)))))))))))), _safe_float_div(((atan2((new Class3())->method0( (!(true	|| false)), ((int)(-(6743917926))), ((int)(urldecode("\000E",)))),	(make_nan())
+      +  (841725.8594459),)) - 0.2112719762102683), (0.6709504770649706)), $v0), $v0,  (strcmp( "#", /* comment */ "(A/\000h{\"key\":1}\"@<div/>Fg?")),  true, 2842.6378 - (Class1::$field3), /* comment */true,  -21675, -1));
+  }
+
+  /**
+   * @param tuple((string[]),tuple(float,int,array<mixed,float>,(int|Interface0),bool),string,int,string,callable(bool,bool):bool,Class6,(?Class5),float,Class2) $p0
+   * @return int
+   */
+  public static function method4(&$p0) {
+    if (!_visit_function("Class3::method4")) return -1;
+    /** @var (int|bool) $v0 */ $v0  = (!true)
+      === (!(Class1::CONST1));
+    /** @var (?Class0) $v1 */ $v1 = /***/ new Class0();
+    try {
+      return (int)(((int)(ord( ("Q#(H[\"val\"]000n,}j!6`l7nハロー・ワールド-123241\000e")) * strlen(("'' bハロー・ワールド>000000MC^ix10x1f'1\n26")))) + strcasecmp(("B><\000[\"val\"]Uハロー・ワールドu000%=,Uy {\"key\":1}<>loui="), (Class1::CONST0)));
+    } catch (Exception0 $_iv2) {
+      dump_with_pos(__FILE__, __LINE__, get_class( $_iv2) . ": " . $_iv2->getMessage());
+      return ((int)(((new Class4(/***/(sizeof( [
+        array(
+          is_writeable(","),
+          ((!((false) /***/ ||	(!true))) && ((bool)(is_bool( $v0) ? ($v0) : ((false &&  false))))),
+          is_file((Class5::CONST2)), // comment
+          (is_infinite((lib0_func0()))) //
+        ),
+        urldecode('</p>0000x1fN'),
+        Class1::CONST1,
+				[
+          ((new Class0())->field2
+            * ((-2222.9999) /**/ - (98184.03511813689))),
+        ]
+      ])),))->field11) + 14073016548)); // comment
+		} finally {
+      dump_with_pos(__FILE__, __LINE__,   'Class3::method4 finally'); // comment
+    }
+  }
+
+}
@@ -0,0 +1,176 @@
+<?php
+class Class4 extends Class3 {
+  /** @var Class1 */
+  public $field8 = null;
+  /** @var Class0 */
+  protected $field9 = null;
+  /** @var callable(float,string,float):string */
+  protected static $field10;
+  /** @var int */
+  public $field11;
+  /**
+   * @param int $field11
+   */
+  public function __construct($field11) {
+    $this->field11
+      = /**/ $field11;
+    $this->field8	= new Class1();
+	} //
+
+
+  /**
+   * @param Class3 $p0
+   * @param Class3 $p1
+   * @return float
+   */
+  public function method5($p0, &$p1) {
+    if (!_visit_function('Class4::method5')) return 156.3249353706236;
+    /** @var callable():float $v0 */ $v0 = fn() => (new Class2(((3.2259428677868904e+06) +  (((new Class0())->field3) ? (((sinh((array_sum([
+      0.00043,
+      "x</p>l5["
+    ]) + acos(/**/0.510730661825614)))) - (0.00043)) - 0.16067258068519535) : (865883.2563026422) -  (make_negative_inf()))), /**/fn() => ("=1\n2@")))->field2;
+    $this->field0 =  $this;
+    $this->field0 = $this;
+    /** @var callable(int,int):float $v1 */ $v1 = function ($a0, $a1) use (/***/$p1) {
+			return ((new Class0())->field2);
+    };
+    {
+      $v2 = [
+				'key'	=> (int)(((int)((Class3::CONST0) - (!(($this instanceof Class3)) ? ((int)(-2222.9999)) : ((strcasecmp(("gUUsimple string24"), (substr_replace((Class1::CONST0),  ("{ E/mY~gy(ハロー・ワールド=z#]9uq2424''JhF8 9PLB}-123%}H"), (int)((int)(((int)(-count(array(
+          1.802741091700504e+06,
+        )))) - (33658))), (int)19584)))))))) * (-16529)),
+        "1 " => /* comment */ $this->field11,
+        'a'	=> (-26242),
+        "key" => ((int)(-((int)((Class3::CONST0) *	((true) && (true || ((true /***/ || true))) ? sizeof(array_keys([
+          ("''u/Ue%N\000Q<p>'Jmq\\simple string+{\"key\":1}1.1''"),
+        ])) : ((int)(((float_eq3((acosh((0.16457733831253163))),  0.6653863963731532,)) ? $this->field11 : (count(array(
+          str_split(("-123u5" . 'ijH0'), (int)(((-34619)) ** 28954)),
+          array(
+            round(34.984408153778006)
+          ),
+          ((int)sin(10.72530566423071,)),
+          array(
+            (is_infinite(754.8463961940944,)),
+          )
+        )))) - ((int)((new Class4((ord("(``C(\"24\0008<p>[,,|iu<div/>j"))))->field11 +
+          ((int)(_safe_int_mod((-31498), /**/((int)(-((int)(((string)json_encode(false,)) .
+          "ハロー・ワールド,-123H"))))))))))))))))
+      ];
+      dump_with_pos(__FILE__, __LINE__,
+         array(
+        "01" => (true),
+        "" => /*
+*/ null,
+        'key' =>  null,
+        "-0" => file_exists( ((string)((!(!(!(!((true) === (!false)))))) &&  ((false) || is_readable('UC*2</p>I'))))),
+      ));
+      $v3 = (float)make_nan();
+
+    }
+    return ((new Class5())->field10);
+  }
+
+  /**
+   * @param callable():float $p0
+	 * @param bool $p1
+   * @param tuple(tuple(float,float,bool,tuple(int,string),(float[]),int,float,float,Class6),int,(string[])) $p2
+   * @return tuple(Class6,callable():float)
+   */
+  public function method6($p0, $p1, $p2) {
+    if (!_visit_function("Class4::method6",)) return tuple(null, fn() => -2222.9999);
+    $v0 = new Class4(((int)(-(-255))));
+		$v1  = /**/ tuple(/**/function ($a0, $a1) {
+			return (((212.3743380094204 +	floor(round(22.11029310995987, /***/ (int)(strlen("")))))) + ((_safe_float_div((21948.293242), /**/(_safe_float_div( (_safe_float_div(((rad2deg(2842.6378) + (162.4337617086818 * (make_negative_inf()))) - (329.5)), ((13.143858500503509 + (52.60778720984313  + 2.51)) /*
+*/ - cos(21948.293242)))), 2.51)))) /* comment */ + exp(/***/((Class1::$field3) +	(-1)))))  - asinh((0.0));
+    });
+    $v2 = ("9{w60x,-123'={SwzR0x1f{\"key\":1}");
+    $v3 = array_count_values([
+      (((!(checkdate(strlen((new Class5())->field4), /***/ (new Class4(/* comment */count([
+        -2222.9999,
+        -1,
+			]),))->field11, ((int)(Class1::CONST0)) & (((new Class0())->method3()) ? ((int)("<p>sN" === $v2)) : ((!(false ||
+        true) ? levenshtein("MI?<div/>JU",
+        /***/'i ') : (sizeof(array(
+        make_nan(),
+      ))))))))) ? -1 : (((Class1::$field3) +	1.5431097907557725)))),
+      [ //*/
+        329.5,
+      ],
+      ("{$p1}Ne{$v2}<p>#{\"key\":1}24="),
+      [
+        (int)((((int)(_safe_int_div(((int)(_safe_int_div(strnatcmp("#-123", "``"), /***/(strcasecmp((string)$v2[9284128 | (-10495)], (strtoupper($v2))))))),   ((int)(_safe_int_mod(((int)((sizeof([
+          true,
+          -37293,
+
+        ])) + /**/ (10380124377))), ((int)(-1850)))))))) |	1444) -  (-50889)),
+        (int)((new Class5())->field10),
+        ((int)((string)(float_eq2((new Class5())->field10, 166.34392919605565)))),
+      ]
+    ]);
+    if (is_readable(((new Class3())->field4))) {
+      throw new Exception0(gettype((!(is_nan($p0())))), 18004830306);
+    } # comment
+    return tuple(new Class6(), $p0);
+  }
+
+  /**
+   * @param callable(int):float $p0
+   * @return (?Class2)
+   */
+  public function method7($p0) {
+    if (!_visit_function("Class4::method7")) return null;
+    $_iv0 = 0;
+    while ($_iv0++ < 10) {
+      $v1 = tuple(new Class6(),	tuple(((415.550514507553 *
+        (asin( (new Class5())->field10 + tan(2.0709749114777055e+06),)))  - (new Class2(303.3564826296202, function () use ($p0) {
+        return Class1::CONST0;
+      }))->field2)
+        * /***/ $p0( ((new Class4((((true) ? 128412288 : ((int)(9284128 + (-1)))))))->field11)), (sin( 21948.293242)) + asin(((_safe_float_div(((new Class5())->field10), floor((-2222.9999)))) - asinh( (991421.0127632669)))),	((string)((int)(_safe_int_div(((int)(14458840353 + ((int)(crc32(((string)((true ? make_negative_inf() : (2.126042205974498e+06))
+        - cosh(-1)))) + (strlen(('ax<p>')) &
+        128412288))))), /* comment */(self::CONST0),)))), [
+        (array_sum([ //*/
+          ((_safe_float_div(0.5438769548531645, (206.98488996286494 /***/ * (1.2051830468053552e+06 - 2842.6378)))) /**/ +  (_safe_float_div(((0.3648629459094769 * 329.5) - (-1)), ((0.0) * pi())))) -  (acosh( (2.51))),
+					!(sizeof(array(
+            1884042539,
+            true,
+          ),) == (((int)(((int)(-(-46003))) +	((int)(_safe_int_mod(20495, 9284128)))))
+            ^  ((int)((-255)
+            - ((int)false))))),
+        ],)),
+
+      ], /* comment */ function ($a0, $a1, $a2) {
+        return (0.5758857496179118); // comment
+      }, ((!(float_eq2((pi()), 3.68746746367362e+06))) && (!((((checkdate(((int)((-26379) * 15631682860)), /**/(int)(-39049), 13476329328 ^ (-255)) ||  ((!checkdate(/*
+*/-255,  -255, 9284128,)) || (float_eq3(/**/(0.5734556904391616 + 166.54953088574464), 329.5)))) || (is_scalar(((string)((int)(255 -  6382774462)))))) /**/ || /**/ ((!is_dir(ucfirst('000'),)) && (((false) ||
+        ((!false) == (false)))  || /*
+*/ true))) && is_scalar(!((is_dir(/***/"\000")) ||	true),)))), strcasecmp(("(K"), ((("</p>5rh24C4C0x1f1\n2/p1\n2<U") . "K1\n2{\"key\":1}Di") .	"#Na")) & ((int)(((int)(((int)(128412288)) * ((int)((-46558) ** strcasecmp(/***/(htmlentities(((new Class3())->field4))), /* comment */'7j # '))))) ** (8884624496))),
+        /*
+*/"Z",
+        new Class3(),  48.98788468601624), new Class6(), -33975, new Class0());
+    }
+    try {
+      $v2 = array(
+				new Class6(),
+        new Class6()
+      );
+      $v3 = (float)((-2222.9999) * /*
+*/ sin( 1.3811243494648668e+06 /**/ - /* comment */ ((exp(_safe_float_div((-2222.9999), (((new Class2(0.571144341255333, fn() => " "))->field2) - ((true) &&	true ? (cos(587.6601019945716)) : ((new Class3())->method0(false,   255, /***/-255,)))))) * /*
+*/ 0.00043))));
+    } catch (Exception2 $_iv4) {
+      dump_with_pos(__FILE__, __LINE__, get_class($_iv4) . ": " . /***/ $_iv4->getMessage());
+      [$v5, , $v6, $v7, $v8] /*
+*/ = tuple(/**/354046.51692189113, ("R''[\"val\"]v''1\n2"), tuple(new Class6(), $p0((int)(-((int)((45863) + ((int)(-(strcmp( ("8[,"  . "ハロー・ワールド"), ("<)``y-<div/>FB924"))))))))) + ((-2222.9999)), ("\000<p> 0x1f{\"key\":1}r</p>oCkPz.Xハロー・ワールド-\"24"), "=[",  '/24(000-123<div/>', ("G1\n2B1-123Z;<p>|{\"key\":1}Ck{ t9X9simple string;p625Y) <h1>ok</h1>~1"),  false,  /***/(floor(653.8733039142041)), Class1::$field3, /* comment */3.210278552116933e+06),	"qU", 0.5670308716786052,);
+      dump_with_pos(__FILE__, __LINE__, $v5);
+      dump_with_pos(/*
+*/__FILE__,
+        __LINE__, $v7,);
+			dump_with_pos(__FILE__, __LINE__, /***/$v8);
+      throw $_iv4;
+    } catch (Exception $_iv9) {
+
+      dump_with_pos(__FILE__, __LINE__, get_class($_iv9) . ": " . $_iv9->getMessage());
+    }
+    return null;
+  }
+
+}
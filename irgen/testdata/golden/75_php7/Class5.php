@@ -0,0 +1,155 @@
+<?php
+class Class5 extends Class3 {
+  const CONST2 = "s,000";
+  /** @var callable(int):string */
+  public $field8;
+  /** @var tuple(string,int,bool,callable():float) */
+  protected static $field9;
+  /** @var float */
+  public $field10;
+  /** @var int */
+  public $field11 = -9284120;
+  /** @var (?float) */
+  private $field12;
+  /** @var tuple(Class5,float,Interface1,int,(int|string),int,string,callable(float):string,string) */
+  public $field13;
+  /**
+   * @param float $p0
+   * @param (Interface1|(bool[])) $p1
+   * @param Class4 $p2
+   * @return tuple((int|(float[])),tuple(int,int,(float[]),string,float,int))
+   */
+  public function method5($p0, $p1, &$p2) {
+    if (!_visit_function("Class5::method5")) return tuple(/* comment */[
+      329.5,
+      0.021615013921355994, // comment
+    ], tuple(9284128,	 -54585,
+      [
+
+      158.89556523494136,
+    ], 'ip<', 329.5, 52632,));
+    /** @var (string|(int[])) $v0 */ $v0 = array_count_values(array(
+      (Class1::CONST0),
+
+      (static::CONST2) .  ('GA%O'),
+      (4.199989517835381e+06),
+    ));
+    $v0 = $v0;
+
+    $p2->field2 = new Class5();
+    return tuple(sizeof(/***/array_flip(array_flip(array(
+      ("T/gj9@[lsimple stringlfQP000{$p0}Tj") . ('P{"key":1}<h1>ok</h1>simple stringL^' .  ("[O" . ("[\"val\"]c=w=7M<h1>ok</h1>I]~U\"*U,\\z"))),
+      (true),
+    )))), /* comment */ tuple(/*
+*/(int)((count([
+      (int)(-((int)(_safe_int_div( ((int)(((int)(((int)false) + ((int)(5599140615 - 48441)))) ** ((int)(((int)((-29792) + (-56998))) ** ((int)")^U"))))), (18826))))),
+
+      [
+        false ||	(is_readable((' ' . "24)"))),
+      ],
+      (("3[1\n2ddvV\\V5*824``@p24FX<%") . ("\00024#rQo{\"key\":1}vp``")),
+      [
+
+        (((string)((new Class3())->field4)) === (urlencode("n'=</p>"))),
+      ],
+    ])) - ((((int)(-((int)((-9284120) - (((int)(-(6423))) | ((int)((47938) + ((int)(((int)(1022783559	- crc32(/***/'ハロー・ワールドN,m'))) * ((int)(count(array(
+      2842.6378,
+
+      4376230096
+    ),) +	(false ? -34604 : 9652)))))))))))) |
+      ((-9284120) | ((int)(233.84295416478201)))))), /**/(10400), array(
+      (21948.293242) - (_safe_float_div(385.9402834969528, ((($this instanceof Class5)) ? 0.5152470549357903 : acos((2.51 -	((_safe_float_div((-1), 0.0))))) +	(206.75574904503915)))),
+      $p0,
+      21948.293242,
+			402.1699063005556 + ((((trim(addslashes("-'FPv"))) === ("<div/>\\uD00024-12324")) ? (round(/*
+*/(Class1::$field3))) : ((_safe_float_div(/***/0.0, (((329.5
+        - /**/ 0.6986090880083776) - (2842.6378)) +
+        (((28771.230337405545) * 329.5) - (0.6599733666445772 - (39.80212343929036)))))) + 329.5)) - 2.51),
+    ), (" ~I]240x1fハロー・ワールド ;,vhSisimple string000,<p>Il.{/K7vOz"), (sqrt(/***/make_positive_inf())), (int)(!(true && (is_readable(/***/("{\"key\":1}_j247j0*1{$p0}}4ELc{rハロー・ワールド=Zy!f1\n2\\#i<-q<p>")))))));
+  }
+
+  /**
+   * @param (bool|(int[])) $p0
+   * @return bool
+   */
+  public function method6($p0) {
+    if (!_visit_function("Class5::method6")) return false;
+    $v0  = [
+      new Class3(),
+      new Class3(),
+      new Class3(),
+    ];
+    /** @var (?int) $v1 */ $v1 = ((int)((strnatcmp(Class1::CONST0,	 " ")) + (((strnatcmp( "``<p>5rJ),gFZel]E<div/>X3M,ik73[\"val\"]|", "-123")) | ((int)(_safe_int_mod((128412288), 255)))) | (-49954))));
+		$v1 = null; //
+    dump_with_pos(__FILE__, __LINE__, ("24"));
+    {
+      $v2 = (float)858.6698137048492;
+      { //
+        /** @var callable(string):int $v3 */ $v3 = /**/ function ($a0) use ( $v0) {
+          return ((int)433.42904410605047);
+        };
+        $v4 = new Class6();
+        $_iv5 = 0;
+        while ($_iv5++ <
+          7) {
+          break;
+        }
+      } // comment
+      $v3 = fn($a1) => (sizeof(array_flip( array( // comment
+        329.5,
+        (bool)(is_bool($p0) ? $p0 : is_finite($v2) && (!is_null($v1))),
+
+        (int)(((int)(((int)(((int)((((int)(is_null( $v1) ? (13314640313) : $v1))  | (-255))	** (-9284120))) + count(array(
+          122.35887312677671,
+					false,
+        )))) - strcasecmp(((ucwords("-123")) .
+          implode("<div/>", array(
+
+          $a1,
+          "]n-123",
+        ))), ("-123]j<p>h")))) +
+          (((int)((new Class4( 37995))->field11 +	((int)(ucfirst($a1))))) | (255)))
+      ))));
+    }
+    return (((new Class4(((int)(($v1) !==	null ? $v1 : (((int)(_safe_int_mod(/* comment */(static::CONST0), ((int)((Class3::CONST0) /**/ ** ((int)(_safe_int_div(((int)(_safe_int_div((0), ((int)((38537)
+      * ((true ? (10897) : 27796))))))), (((int)(strnatcmp("``", "000") **	((int)(17046951360 +	((-14334)))))) /**/ ^ (new Class4(0))->field11))))))))))))))->field11) /* comment */ == (Class3::CONST0));
+  }
+
+  /**
+   * @param tuple(callable(int,bool):int) $p0
+   * @param (Class6[]) $p1
+   * @param string $p2
+   * @param Class4 $p3
+   * @param Class5 $p4
+   * @return int
+   */
+  public function method7($p0, $p1, $p2, $p3, $p4) {
+    if (!_visit_function("Class5::method7")) return 35312;
+    list("-0" => $v1, '' =>
+      $v2, "a"
+      => $v0)	= array(
+      'a' =>
+        ((new Class0())->field2),
+      " 1" => ((-1)
+        + (2.51 - /* comment */ (atan2(((new Class4(-255))->method0((float_eq2((-1), (new Class3())->method0(false, 44726, 6233))),	 (255 ^ 4321088728) | ((int)((-62550) * 6304439291)), ((strcmp("_x-U", "S1\n2")) & ((int)((-29801) - (-13543)))))
+        * cos(atan(-1) +
+        ((true ? (1.1715426742299707e+06) : 21948.293242)))) - (new Class2(/**/('ij-123v' === "=" ? (ceil(759.014612333132,)) : _safe_float_div(687760.9786811083, 3.1313079106962327e+06)) + ((-1) -	(deg2rad(2.51,))),  fn() => (new Class3())->field4))->field2, /*
+*/(8.55311037535065e+06 -  (0.0)) - (make_nan()))))) + (make_positive_inf() - 38.21402030159382),
+      '-0' => null,
+      "" => null # comment
+    );
+    dump_with_pos(__FILE__,  __LINE__, $v0);
+		dump_with_pos(__FILE__,  __LINE__,	$v1);
+    dump_with_pos(/* comment */__FILE__, __LINE__, /**/  $v2);
+    try {
+
+      return -35489;
+		} finally {
+      dump_with_pos(/*
+*/__FILE__,	__LINE__, "Class5::method7 finally");
+      return 1262523983;
+    }
+  }
+
+
+}
@@ -0,0 +1,345 @@
+<?php
+class Class6 extends Class2 implements Interface1 {
+  /** @var (bool|Class0) */
+  public $field5 = false;
+  /** @var callable(float,string):string */
+  private $field6;
+  /** @var (string|Class2) */
+  private $field7 = "5'',|R"; //*/
+  /** @var (string[]) */
+  protected static $field8;
+  /** @var (?int) */
+  public static $field9 = null;
+  public function __construct() {
+    parent::__construct(/**/4.1638355395325776e+06, fn() => "ハロー・ワールド");
+  }
+
+  /**
+   * @return callable():string
+	 */
+  public function method7() {
+    if (!_visit_function(/* comment */"Class6::method7")) return fn() => '<h1>ok</h1>';
+		$v0 = new Class6();
+    $v1 = (float)(218.92699686065785);
+    $v0	= new Class6();
+    /** @var callable(bool):bool $v2 */ $v2 =  fn($a0) => $a0;
+		return function () use ($v0) {
+      return 'Sy'; //
+    };
+  }
+
+  /**
+   * @param float $p0
+   * @param int $p1
+	 * @param (bool[]) $p2
+   * @return (string[])
+   */
+	public static function method8($p0, $p1, $p2) {
+
+    if (!_visit_function('Class6::method8')) return [
+      "<p>",
+      '{"key":1}'
+
+    ];
+    /** @var callable(float):float $v0 */ $v0 = function ($a0) {
+      return (asin(exp($a0)));
+    };
+    /** @var bool $v1 */ $v1	=
+      Class1::CONST1;
+    /** @var (int|Interface1) $v2 */ $v2 = /**/ ((int)(-(9284128)));
+    $v3 = new Class0();
+    $v4 = (trim(sha1(/*
+*/(ltrim("-]",	"{$p0},L}:~B6D")),	(Class1::CONST1) || (new Class0())->field3,), (((string)(((!(is_infinite(/***/(0.00043)))) || (Class1::CONST1)) && ((!((("{$p1}qrハロー・ワールド") == trim("@<p>")) && is_finite(round(-2222.9999)))) || ((false) && ((new Class5())->method6(/* comment */array_count_values(/*
+*/array(
+      'WDg',
+      true,
+    )))))))) . ("000{$p1}0x1fw}Vk"))));
+    if (((false) /* comment */ || ((!(float_eq3(/**/$p0, 121.44869449589609))) || (true)))) {
+
+      throw new Exception0($v4, 52572);
+    }
+
+    return [
+			" B1\n2|.",
+    ];
+  }
+
+  /**
+   * @param tuple(Class6,float,(bool|(string[])),Class0,int,Class0,(bool|float),Class1,(float|string),Class3) $p0
+	 * @return (?int)
+   */
+  public static function method9($p0) {
+    if (!_visit_function("Class6::method9")) return 5778;
+    $v0 = "-U6{(0K'r{\"key\":1}/k\\b> /";
+    $v1 =	$v0;
+    try {
+      $v2 = tuple((is_scalar((-47632))), ",''", tuple( ((255) & 128412288), $v1, new Class5(), array(
+        3 => (sinh(((new Class2((_safe_float_div(((2.3234377888709498e+06) *
+          ((new Class5())->field10)),	((new Class5())->field10))) - ((sin((cosh(make_positive_inf()))))), function () use ($v0) {
+          return ((string)$v0[(-36209)]);
+        }))->field2))),
+      )), 89.60590008445095, 40361, /*
+*/(int)(-(Class3::CONST0)), exp(deg2rad(/**/21948.293242) + ((((!($v1 === $v1)) && (((((new Class5())->method6([
+        50128,
+      ]))  && /* comment */ (!(true && true))))	&& (((false
+				&&
+        true) &&	(true)) || (!is_writeable("<h1>ok</h1>"))))) && (is_writeable(" <h1>ok</h1>IwP<div/>, ^wL,RLJ5I<p><h1>ok</h1>",)) ? _safe_float_div(0.00043, (_safe_float_div(((2842.6378)  + ((new Class6())->field2)),
+        (Class1::$field3)))) : 0.14602547194386087))), /**/ (int)(42941 + (-37832)));
+      /** @var callable(float,string):bool $v3 */ $v3 = function ($a0, $a1) use ( $p0,  $v1, $v2) {
+        return (false);
+      };
+    } catch (Exception2 | Exception0 $_iv4) {
+      dump_with_pos(__FILE__, __LINE__, get_class($_iv4) . ': ' . $_iv4->getMessage());
+      $v5 = 2842.6378;
+			throw $_iv4;
+    } catch (Exception0 $_iv6) {
+
+      dump_with_pos(/***/__FILE__, __LINE__,
+        /**/get_class($_iv6,) . ': ' . $_iv6->getMessage());
+		} catch (Exception1 | Exception2 $_iv7) {
+      dump_with_pos(__FILE__, __LINE__,   get_class($_iv7) . ": " . $_iv7->getMessage(),);
+      throw $_iv7;
+    } finally { # comment
+      $v8 = [
+        "2",
+        "simple string",
+        '-1232o\'</p><p>',
+      ];
+    }
+    /** @var (int|(bool[])) $v9 */ $v9 =  ((int)(levenshtein($v1, $v1) +  ((int)(((false === /***/ (!(Class3::CONST1))) ? (levenshtein($v1, ("{$v1}''/L-123 {$v1}"),)) : ((int)((-23988)  + (-49000)))) + ((int)((ord($v1)) + 12778))))));
+    return 128412288;
+  }
+
+  /**
+   * @param float $p0
+   * @param Class6 $p1
+   * @param Class6 $p2
+   * @param (string[]) $p3
+   * @param (?int) $p4
+   * @param (?(float[])) $p5
+   * @return callable(int,int,string):float
+   */
+  public function method2($p0, $p1, $p2, $p3, $p4, $p5) {
+    if (!_visit_function("Class6::method2")) return fn($a0, $a1, $a2) => 1.4799467815678548e+06;
+    $v0 = new Class1();
+    $v1 = new Class2(/*
+*/((new Class5())->field10),  fn() => rtrim(",C~xNjD;\000"));
+
+    usort($p3,	function ($a, $b) {
+			return strcmp($a, $b);
+    });
+    dump_with_pos(__FILE__,  __LINE__, $p3);
+    return parent::method2($p0, $p1,  $p2, $p3, $p4, $p5,);
+  }
+
+
+  /**
+   * @param (?Class1) $p0
+   * @return Class0
+   */
+  public function interface0_method0(&$p0) {
+		if (!_visit_function("Class6::interface0_method0")) return null;
+    /** @var callable(string,string):int $v0 */ $v0 = fn($a0, $a1) => (crc32(("p2'``]O<div/>/uBCv")));
+
+    switch ((is_finite(asin(((_safe_float_div(((_safe_float_div(165.85140618789208, (555129.1754310501)))
+      +	(-2222.9999)), (71.6281141363162))) - (0.47235446701046274 - 21948.293242)))) ? (((Class1::CONST1) ? (sinh(/*
+*/(make_nan()))) : 1.6693531255153355e+06) - deg2rad(5.10792738905125e+06 - ((5.924496184736368e+06) - (_safe_float_div(((false ? (43.32994248499102) : 37.63276785857703) + (new Class0())->field2), (300.7488791522731)))))) * ((136.30544836690984 - make_positive_inf())  + (186.6005528559807)) : (acos(/* comment */(tan(((2.51) /*
+*/ - (21948.293242))))))) - (make_negative_inf())) {
+      case (329.5 * (((sqrt(/*
+*/((rad2deg((round(900.9109895137854, (int)((int)((-9322) + (-9284120))))))) + 0.37848531537450314) * lib0_func0()))) * 175.3906615911513)):
+        break;
+      default:
+        $v1 = new Class5();
+        $v2  = tuple(new Class6(), new Class2((make_nan()) - (-1), fn() => Class1::CONST0), "2#4!H9)kxyK+)o?", (string)json_encode(false),  "O.z|" === ("/K(\000,"),  new Class6(), !((is_file("<div/>" . "h")) && (file_exists("````Ps5>[-R<h1>ok</h1>-+rx*-123{f^a#"))), !((new Class0())->method3()),  new Class6(), tuple((checkdate(34534, /***/ (int)((((int)(-(128412288))) | ((int)((int)((63194) - crc32("B(PE]"))))) * (-255)),
+          ((int)(_safe_int_mod((Class3::CONST0),  ((int)(_safe_int_mod(((int)(-strnatcmp(/***/"'",  (addslashes( 'Z'))))), ((int)(((int)(-((int)(_safe_int_div((255), (-58019)))))) /*
+*/ - ((int)(strcasecmp('h[', /* comment */ "\\`.x1\n2") - ((new Class4(-1))->field11))))))))))))) ||
+          (!false)), (int)(-(strlen((long2ip((int)((int)((new Class2(/***/3.556080858300512e+06,  fn() => (",)e[yUB9q-123/``q''Eiハロー・ワールド <div/>isimple stringD")))->field2))))))),
+          array_count_values(array_flip(array_filter(array_flip([
+          [
+            (2842.6378)	+ ((new Class3())->method0(!(true ||  false),  47464, ((int)(_safe_int_mod(50057,  18083,))) ^ ((int)(-255)))),
+            (2.51),
+
+            (make_nan())
+          ], // comment
+				]), function ($a2) use ( $v1, $p0, $v0) {
+          return (array_key_exists( "&/:=G`n<wi*\000a", array( //*/
+            array( //*/
+              ((((!true) && (true && true)) ? $v1->field10 : ((atan2( 0.00043, make_positive_inf()) * (2842.6378 + 2842.6378))))),
+							((new Class0())->field2), # comment
+              ((make_positive_inf()) + (0.0)),
+            ),
+            str_split(("@Oハロー・ワールドO.<p>yZMl+xハロー・ワールドw?r<p>X''/9.<div/>"),),
+            (urlencode(/*
+*/("<,,{\"key\":1}</p>NI{z8Vsimple stringd''Gv")) .  ((string)((int)(_safe_int_mod((255
+              | 22153), ((new Class4(73206545))->field11),))))),
+						$a2,
+          )));
+        }))));
+    }
+    dump_with_pos(__FILE__,  __LINE__,  -4005);
+    return parent::interface0_method0($p0,);
+  }
+
+	/**
+   * @param callable(int,bool,float):int $p0
+   * @param (Class1|(bool[])) $p1
+   * @param float $p2
+   * @param (((bool[])|string)[]) $p3
+	 * @param array<mixed,(int|Class6)> $p4
+   * @return Class1
+   */
+  public function interface1_method0($p0, $p1, $p2, &$p3, $p4) {
+    if (!_visit_function('Class6::interface1_method0')) return null;
+    $v0 = Class1::CONST0;
+    $v1
+      = /**/ tuple(fn($a0, $a1) => (float_eq3($a1,  /**/(2842.6378	- 0.0))), fn($a2) => Class1::CONST0, function ($a3, $a4, $a5) use ($p1,	$p0) {
+      return "24{$a5}T{\"key\":1}\000{$a3}{$a5} )Osハロー・ワールドsB9NaE3";
+    }, (int)((-21589) ** (((int)(60610  + ((int)(496902.23177859414)))))), (is_file($v0)), sizeof( array_keys(array_keys([
+      (Class1::$field3)  -	(202.11725599083078)
+    ],))), '<div/>',  (make_negative_inf()) +	(is_infinite($p2) ? (((new Class2( (2.51), /*
+*/ fn() => ((string)(Class3::CONST0))))->field2)) : 488.29104768778865), function ($a7, $a8, $a9) use (/***/$p4) {
+			return $a9;
+    });
+    $v2 = tuple((new Class0())->method3(),  array(
+      " ",
+      "]``"
+    ),  [
+      "1 " => tuple((strnatcmp(/* comment */"''j>B0``ztK</p>s''B<p>[6o``{$v0}p1ハロー・ワールド-123<p>dy<h1>ok</h1>W[\"val\"]\\2x,", ucwords(((("2A&pX/") . "000")  . ",24\000|;")))),	rtrim(((string)$v0[(-32355) & (9284128)])), /* comment */$v0, /*
+*/$v0, make_positive_inf(), ((false) || array_key_exists($v0, array(
+
+        asin(257.41143967954974) +  ((_safe_float_div(345.5392127544931, 169.46628545294197)) + (42.04232266219725 - 0.09326684175054992)),
+        (ceil(433.7058181717299
+          * 2842.6378)),
+      ),) ? (496.0134624623994) : ((new Class0())->field2))),
+      "" =>
+        tuple((strnatcmp('\'\'', $v0)), /***/ "wGqQ", $v0,
+        "o1\n2" .  (")|g"),   ((0.3948233685230522) + array_sum(array_keys([
+        15958118815,
+        array(
+          $p2,
+          $p2,
+					(-1),
+        ),
+        ("_[\"val\"]}{\"key\":1}{$v0}{$v0}y)b:@Q\\0d;q</p>"),
+        array_count_values(array(
+          (int)0.6198663793904742,
+					((new Class0())->field3)
+        )),
+      ]))), ((atan(((new Class5())->method0(checkdate(-40986, 967905961, 12356605248), /*
+*/ 6316,  ((int)((-45596) ** (-8958))))) - make_positive_inf()) - (2.51 * (new Class0())->field2)) + /***/ ((new Class6())->field2))),
+			"1.5" => tuple((strlen($v0)), $v0, $v0, 'K#Ncd[', $p2, $p2,)
+		],	/***/$v0, /*
+*/[
+      checkdate(((int)((int)"|0x1f")), /* comment */((((int)(((int)((strlen($v0,))	- (9284128)))
+        + ((int)(((int)((-38504)	** /*
+*/ ((int)(-((int)(((int)((-9284120) + (-255)))  **
+        ((int)(0 * /***/ 0)))))))) - (($p0(/**/((true ? -42119 : (0))), true, /*
+*/  (new Class5())->field10) &  (9284128)))))))) /*
+*/ | /**/ (-1)), (int)((levenshtein(("\000 " . "Ki9-WQ"), ("\000" . ltrim((trim( $v0)), "Mx-123,Q")))) ** sizeof(array_map( fn($a10) => [
+        (505.1064914334543), // comment
+        (0.0),
+        -2222.9999,
+				$p2,
+      ], array_map(function ($a11) use ( $p1, $p3) {
+
+        return sizeof([
+					9.942784119200509,
+          false,
+        ]);
+      }, /***/array(
+        $p2,
+
+      )))))),
+      true, // comment
+      ((Class3::CONST1) || (!(float_eq3( (new Class2($p2,
+        fn() => (gettype(!true))))->field2, /***/ $p2)))),
+      ((new Class5())->method6((((false) /**/ || (((false)	&& (true /***/ || /**/ true)) && (file_exists(',b24fCV') /*
+*/ || (true && true)))) && (!((float_eq2($p2,  $p2)) ===	true))) === (is_nan(/**/(_safe_float_div(2842.6378, (66.71201041195916)))))) ||  true),
+    ], $p2, /***/  tuple((false &&
+      false), (-9284120),  true, false && (Class3::CONST1), $this, fn() => substr_replace( ("{$p2}{$p2}''({$v0}"),  ("{$v0}[\"val\"]+u0x1fFT\000\"z") . ("."),
+      (int)(Class3::CONST0)), (_safe_float_div(sin((129.86623033957872)), /* comment */(((0.00043)) + (329.5)))), /**/  (((int)(($p0(/* comment */(-58671), (false),  (new Class3())->method0((Class1::CONST1), -418, 37033) - ((new Class5())->field10),)) - (Class3::CONST0)))) | crc32($v0,),  new Class5(), new Class0(),  make_positive_inf(),
+      (_safe_float_div((round(136.3518768823849,) - (0.16795887362639153)),   (_safe_float_div(224.30264127416834, tan(((new Class2((floor(329.5))
+      + ((-1) - /*
+*/ (new Class5())->field10),  fn() => "\"' ,d"))->field2))))))),  ((string)("y"	. ("Fq??_1\n20x1f.E{$p2}{$p2}{\"key\":1}TPnz{\0000g0x1f</p>mB<h1>ok</h1><div/>[\"val\"]"))), null,	new Class3(), strlen( ((new Class3())->field4)) ===
+      ((int)(((int)((int)(-((int)(((int)((-58265)
+      -	((6667 & (-9284120)) ^ ((((int)'S') & ((int)((-255) + (-1)))) & /*
+*/ ($p0(-39925,	false, /*
+*/21948.293242,)))))) + (ord(/***/htmlentities($v0)))))))) - (-255))), new Class1());
+		foreach ($p3 as &$v3) {
+      $v3 = array(
+        ((!((Class1::CONST1)  == ((array_key_exists($v0, array_flip(array(
+          (0.00043),
+        )),)) || ((false || (!(is_nan(2.557002381468772e+06,) && ((false	&& false) === (false))))) || (false))))))	&& ((!(($this instanceof Class2))) &&  ((((float_eq3($p2,
+           $p2)) || (!(is_array($p1))))	|| ((!(8478158496 == $p0((9284128),
+          false, 80.46184296109467))) /* comment */ || true)) &&
+          (is_readable(/* comment */$v0,)))), //*/
+        ((!(is_readable("simple string[\"val\"]Htsimple string{$v0}{$p2}") === (((((false)
+          && ((checkdate(((-18167) |
+					9284128), (strnatcmp($v0, "ハロー・ワールドDe")), /***/0 ^ (-255))) /*
+*/ && (false)))  || (array_key_exists((lcfirst(/**/md5( "E3l", /*
+*/true))),
+          [
+          ('D'),
+          '12}',
+        ]))))
+          &&	(!((false) || true)))))
+          && (false /* comment */ && ((false || /***/ (new Class0())->field3)))),
+      );
+    }
+    unset(/*
+*/$v3);
+    foreach ($p3 as $v3) {
+      dump_with_pos( __FILE__, /* comment */__LINE__, $v3);
+    }
+    dump_with_pos(__FILE__,
+      __LINE__, $p3);
+    $v4 = (int)0;
+    $p3 = array(
+      [
+        file_exists("``p"),
+				false,
+        ((new Class0())->method3())
+      ],
+    ); //
+    return new Class1();
+
+	}
+
+  /**
+   * @param tuple((int[]),float,int) $p0
+   * @param float $p1
+   * @param Interface1 $p2
+   * @return (float|Interface1)
+   */
+  public function interface1_method1($p0, $p1, $p2) {
+    if (!_visit_function(/*
+*/"Class6::interface1_method1")) return null;
+    $v0 =	array(
+      5 => $p1,
+			0 => (899.1636381199124),
+    );
+    $v1 =	new Class6();
+    foreach ($v0 as &$v2) { //*/
+      $v2 =
+        (18090542140 == ((int)(crc32("D#0x1f{$p1}{$p1}") - ((!(!((!(("_<p>KHO{$p1}</p>\"\000l<div/>&Cb.simple string{nl4{$p1}") == "<p>osR")) ||	(!(!(true && (float_eq3($p1,
+        $p1))))))) ? (levenshtein( "0x1f", (htmlentities((("ek0x1fa%ハロー・ワールドriN-123l24k{\"key\":1}~cu{$p1}`") . (md5("#l</p>", true) . ("f" /**/ . "-123"))))))) : ((int)(_safe_int_mod((new Class4((ord(/**/"{\"key\":1}v9A"))))->field11, /*
+*/((int)(strnatcmp((Class5::CONST2), stripslashes("{\"key\":1}Qs%Uハロー・ワールドハロー・ワールド<div/>2)IO[\"val\"]")) ** (-42900)))))))))) ? ((0.5223350081170284)) : (0.00043));
+    }
+		unset(/*
+*/$v2,);
+    foreach ($v0 as $v2) {
+      dump_with_pos(__FILE__,  __LINE__, $v2);
+    }
+    dump_with_pos(__FILE__, __LINE__, $v0);
+    foreach ($v0 as $v3 => $v4) {
+      dump_with_pos(__FILE__,  __LINE__, $v3);
+      dump_with_pos(__FILE__, __LINE__, $v4);
+      $v0[1] = ((float_eq3($v4,
+        $v4) ? (((make_nan())) - 182.67245605579276) : (Class1::$field3)));
+      dump_with_pos( __FILE__, __LINE__,	$v0);
+    }
+    dump_with_pos(__FILE__, __LINE__,  2.5646920101481907);
+    return new Class6();
+  }
+
+}
@@ -0,0 +1,3 @@
+<?php
+class Exception0 extends Exception {
+}
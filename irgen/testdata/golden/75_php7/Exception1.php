@@ -0,0 +1,3 @@
+<?php
+class Exception1 extends Exception {
+}
@@ -0,0 +1,14 @@
+<?php
+interface Interface0 { //*/
+	/**
+   * @param (?Class1) $p0
+   * @return Class0
+   */
+  public function interface0_method0(&$p0);
+  /**
+   * @param Interface1 $p0
+   * @param bool $p1
+   * @return (bool|float)
+   */
+	public function interface0_method1(&$p0, $p1);
+}
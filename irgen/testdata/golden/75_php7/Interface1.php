@@ -0,0 +1,19 @@
+<?php
+interface Interface1 {
+  /**
+   * @param callable(int,bool,float):int $p0
+	 * @param (Class1|(bool[])) $p1
+   * @param float $p2
+   * @param (((bool[])|string)[]) $p3
+   * @param array<mixed,(int|Class6)> $p4
+   * @return Class1
+   */
+  public function interface1_method0($p0, $p1, $p2, &$p3, $p4);
+	/**
+   * @param tuple((int[]),float,int) $p0
+   * @param float $p1
+   * @param Interface1 $p2
+   * @return (float|Interface1)
+   */
+  public function interface1_method1($p0, $p1, $p2);
+}
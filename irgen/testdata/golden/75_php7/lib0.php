@@ -0,0 +1,128 @@
+<?php
+/**
+ * @return float
+ */
+function lib0_func0() {
+  if (!_visit_function("lib0_func0",)) return make_positive_inf();
+  /** @var callable():string $v0 */ $v0 = fn() => "<h1>ok</h1><p>``24R24Z4DCハロー・ワールドGq:''";
+  $v1 = (int)((int)(128412288 /**/ * 0));
+  list(, , $v2, $v3, , $v4, $v5) = tuple($v1, new Class5(),	(198.12528943537222), ((!(is_file(/**/"~v"  . (addslashes( ("<p>[\"val\"]24ae" . "ZEt P")) . ((("a1\n20x1fハロー・ワールド?P") . "Rv%j") . (lcfirst( " ")))))))	&& ((new Class0())->field3))
+    || (!(is_readable(/*
+*/"{\"key\":1}Z1\n2ハロー・ワールド[\"val\"]") && (false  && (((((false	&& (((int)(62653 **
+    (255))) == (true ? 13646462165 : -39089))))
+    &&	(true)) /*
+*/ && /* comment */ (false	&& (false /**/ ||  (is_nan(1.63874361325089e+06) ||  false)))) || true)))), "b6S1\n2dF]hm-123eL000W4n", new Class5(),	/*
+*/new Class4($v1));
+  dump_with_pos(__FILE__,
+    __LINE__, $v2);
+  dump_with_pos(__FILE__, __LINE__,  $v3);
+  return $v2;
+}
+
+/**
+ * @param string $p0
+ * @param (Class1|float) $p1
+ * @param string $p2
+ * @param tuple((?float),int,array<mixed,(int|string)>,callable(string):int,float,callable(int,string):int,tuple(float,bool,Class4,(bool|string),bool,Class5,float,string)) $p3
+ * @return tuple(bool,float)
+ */
+function lib0_func1($p0, $p1, $p2, $p3) { // comment
+  if (!_visit_function("lib0_func1")) return tuple(true,
+    make_positive_inf());
+  $v0 =
+    "xハロー・ワールドY";
+	$v0 = $v0;
+  $v0 = $v0;
+  $_iv1 = 0;
+  while ($_iv1++ <
+    6) {
+    $v2 = new Class2((((float)(is_float($p1) ? $p1 : (((float)(is_float($p1) ? ($p1) : (21948.293242)))))) * 0.560319662293097),  fn() => ((string)$p2[(int)((make_positive_inf())  + (((acos((_safe_float_div((((-1)) - /**/ 11.907228947947464), /* comment */ /***/(rad2deg(304126.7692992708)))) + ((float)(is_float($p1) ? $p1 : 21948.293242)))) * (Class1::$field3))))]));
+		dump_with_pos(__FILE__,  __LINE__, /**/"``z<div/>i");
+
+    /** @var (float|Class3) $v3 */ $v3 /* comment */ = new Class5();
+  }
+  return tuple(true, (0.8131789500603687));
+}
+
+/**
+ * @param (string|int) $p0
+ * @param (int|Class1) $p1
+ * @param tuple((string[]),callable(bool,int):int,bool,float,string,float,float) $p2
+ * @param tuple(Class4,string,string,Class0) $p3
+ * @param Class3 $p4
+ * @return tuple(float,(Interface0[]),string)
+ */
+function lib0_func2($p0, $p1, $p2, $p3, $p4) {
+  if (!_visit_function("lib0_func2")) return tuple(0.8828331917856844, [
+    null, // comment
+    null,
+    null,
+  ], "5\000`Z,<div/>");
+	switch ((Class1::$field3)) { //
+    case 0.051038629134777136:
+			break;
+    case (!((new Class0())->field3 && (!(((is_readable("[\"val\"]~#``iPEo\000e")) &&  is_writeable( (string)crc32("F5"))) && (true || is_readable("0x1f"))))) ? (402.06625418518837)  * 833.1695641162811 : ((round(2842.6378)))):
+      dump_with_pos(__FILE__, /**/  __LINE__, (int)(927917.1677311325));
+      switch (2.51) {
+        default:
+          $v0 =  new Class5();
+      }
+			break;
+    case 329.5:
+      $v1 = tuple([
+        stripslashes("lsimple string Srsimple string Z<p>"),
+        ((string)((((Class3::CONST1) && true) ? sinh((2842.6378)) : ((-2222.9999))) +  acosh( ($p4->method0((true), /***/ /**/sizeof([
+          59.5386162250313,
+        ]),  (int)(_safe_int_div(((int)(_safe_int_mod(((int)(_safe_int_mod(0, 13641852294))),  (levenshtein("1\n2 ", /*
+*/"<div/>G_8hW"))))), /*
+*/ 65456))))))),
+        ((string)(rad2deg((make_positive_inf()) - (-1))))
+      ], 58.577743818472676, ((int)(((int)(is_int($p0) ? ($p0) : -43106)) ** ((new Class4(((int)(count(array_filter(array_flip([
+        explode(/* comment */((string)(-7440)), ('000'), (9284128 | /*
+*/ (-1))),
+				(string)(is_string($p0) ? $p0 : ("{ba1\n2")),
+        [
+          ((new Class4(35383))->field11)
+        ]
+      ]),
+				fn($a0) => (is_infinite(0.00043)) || (false)))))))->field11))), ((int)(((strcmp(($p4->field4),  ('6' . ("{\"key\":1}()n}<h1>ok</h1>\0000x1f#*uu&"))))  & (8834)) * (levenshtein(("[\"val\"]000 simple stringaWh"), '["val"]:')))) ^ ((int)((new Class0())->field3)), function ($a2) use ($p2, /**/$p4, $p3) {
+        return $a2;
+      }, tuple(true,	 new Class6(), /***/ !(false && /***/ (false || ((is_scalar('ハロー・ワールドD000</p>%r'))  && ((false || false) &&	((!(addslashes("0x1f3simple stringA8<div/>") === /* comment */ (lcfirst("FW") . ((string)(is_string($p0) ? ($p0) : ("d];"))))))))))),	((int)((255) ** ((int)((Class3::CONST0)	- ((int)((new Class4(/***/((false || ((false || false) &&  is_file("s"))) ? (((int)(_safe_int_mod(0, (new Class4(29722))->field11)))) : (((int)(is_int($p1) ? $p1 : ((Class3::CONST0))))))))->field11 * ((((int)(_safe_int_div(sizeof(array_keys(array(
+        329.5,
+
+        false,
+      ),)), levenshtein(("Pbr</p>O<h1>ok</h1>24C<div/>S''~</p>1\n2ハロー・ワールド"), (string)json_encode(true)),))) & strlen((string)'9')))))))))), /**/ array(
+        (int)(-((int)(is_int($p1,) ? ($p1) : (((int)(strnatcmp(Class5::CONST2, (Class5::CONST2)) + (count(array_keys(array_map(fn($a3) => (false), array(
+          (pi()),
+          (true ? (0.00043) : (make_nan())),
+				)))))))))))
+      ),	[
+        ((string)(is_string($p0) ? $p0 : ((new Class3())->field4 . ((">W4!7,000T!CX?!<p>-123[fF%24KRH4245Q<div/>") /**/ .	'000')))),
+      ], (string)(is_string($p0) ? ($p0) : ((((string)(is_string($p0) ? ($p0) : ("8k<h1>ok</h1> 9 ")))) /* comment */ . preg_quote(((Class1::CONST0) . /* comment */ trim("-123", ("000J''ハロー・ワールド{\"key\":1}\".1\n2h1\n2<div/>gUI-123,\"'m")))))));
+      break;
+  } //*/
+  dump_with_pos(__FILE__,	__LINE__,  (new Class0())->field3);
+  {
+    $v2 =
+      tuple(/**/(int)(strlen(/*
+*/"ハロー・ワールド-123']" .	("<div/>&T,J''ON)P}0x1f-123}{\"key\":1}Vb{\"key\":1}")) + (strlen(/**/(string)acos(-2222.9999)))), ((int)((strcasecmp("h</p><h1>ok</h1>mx2l``5Dsimple string", (string)(is_string($p0) ? ($p0) : (((string)((string)((int)(7037
+      + 255)))) .	(" ,*jF,")))) /* comment */ ^
+      ((int)(is_int($p1) ? $p1 : (int)(!(!(urldecode('\'\'') ==	(base64_encode( "1\n2}i#<h1>ok</h1> ")))))))) - /***/ ((int)(is_int($p0) ? ($p0) : ((int)(-49492)))))), new Class0(), 0.7853513559925426,
+      /* comment */(241.4607782415261),  new Class2( (((is_readable("v{JI{6 ハロー・ワールド{\"key\":1}+Z,")) ? (((new Class5())->field10)) : pi())), /*
+*/function () use ($p1) {
+      return "}p4LJw</p>E7Ga&\\{\"key\":1}uf<div/>L`/<h1>ok</h1>K0x1fT\000";
+    }), new Class0(), (string)(_safe_float_div((((468.4132799807188)  * ((float_eq3(/*
+*/(new Class5())->field10, /* comment */ (lib0_func0() +	make_positive_inf())) ? ((0.99978214013821 * (419.3751857584573))) : 218.8215172417548))) + (245.63083113296187)),  ((new Class2(acosh(make_nan()), function () use (/***/$p1) {
+      return "0x1f";
+    }))->field2))), make_positive_inf(), (false),  new Class6(), asin( pi()));
+  }
+  return tuple( (new Class4(-9284120))->method5(new Class3(), $p4) - /**/ (fmod(_safe_float_div((make_nan()), ceil( floor(595.5620387439881) * 75.92516427090774)),
+    round(((((((!true) && (true	||	true)) === (false)) ? (((new Class2(/**/-2222.9999,  fn() => '<A000?n#'))->field2 - ((('{"key":1}' === "\"") ? (329.5) : (((new Class0())->field2)))))) : 0.5639443008159698)) - (ceil( (true === false ? 227.52890431796735 - (make_nan()) : (new Class4(128412288,))->method5(/**/new Class3(), $p4,)))))))), /**/array(
+    new Class2(/* comment */(-2222.9999), function () use ($p4, $p1) {
+      return "|K!``'<p>simple string`U \\i1\n20x1f<div/>-";
+    }),
+    new Class6(),
+    new Class1()
+  ), ("1") .	("<div/><h1>ok</h1>86"));
+}
+ //
@@ -0,0 +1,98 @@
+<?php
+/**
+ * @param array<mixed,Class6> $p0
+ * @param string $p1
+ * @param array<string,(?(float[]))> $p2
+ * @param string $p3
+ * @param Interface1 $p4
+ * @return callable(float,float):string
+ */
+function lib1_func0($p0, $p1, $p2, $p3, $p4) {
+  if (!_visit_function(/**/"lib1_func0")) return fn($a0, $a1) => 'V';
+  /** @var (string|bool) $v0 */ $v0 = /*
+*/ $p1;
+  $v0 = /***/ ",]KL{2Z(.{$p1}";
+	$_iv1 = 0;
+  while ($_iv1++ < 3) {
+    foreach ($p2 as &$v2) { //
+      $v2 = /**/ [
+        (Class1::$field3),
+        (2.51),
+      ];
+    }
+    unset(/***/$v2);
+    foreach ($p2 as $v2) {
+      dump_with_pos(__FILE__,
+        __LINE__, $v2,);
+    }
+    dump_with_pos(__FILE__, /* comment */ __LINE__, $p2);
+    /** @var (float|int) $v3 */ $v3 = make_positive_inf();
+    /** @var callable(string):float $v4 */ $v4 = function ($a2) use ($p1, $v0, $p3) {
+      return array_sum(array_keys(array_flip(array_map(function ($a3) {
+        return 1.1607879037533768e+06;
+      }, [
+        ((Class1::CONST1) || (!is_scalar(115.98931640380289))) /*
+*/ || /*
+*/ false,
+        (false),
+        !((true)
+          || true), //
+      ],)))); // comment
+    };
+  }
+  if (((int)(crc32( $p1) + (-14770))) == (((int)((new Class4((-4761)))->field11 - strcmp($p1, (string)(is_string($v0) ? ($v0) : ($p1))))))) {
+
+    throw new Exception2("<h1>ok</h1>RdhW''My<div/>B24r ?L-123 000-123",  32989);
+	}
+  return fn($a4, $a5) => $p1;
+} //*/
+
+/**
+ * @return float
+ */
+function lib1_func1() {
+  if (!_visit_function("lib1_func1")) return 4.447069873716543e+06;
+  /** @var callable(string):float $v0 */ $v0 = /* comment */ fn($a0) => (lib0_func0());
+  $v1 = tuple("0006J24m# [\"val\"] ?\000-000", null, "Y}G24B1\n2{{\"key\":1}{\"key\":1}v{\"key\":1}simple stringT<div/>1\n2cIwo``P~-x;3RO</p>'", /***/ (asin(sqrt(4.110875616774115e+06))  + (((ceil(rad2deg((is_readable( ",") ? (((make_negative_inf())) - (6.480121512725459e+06)) : ((array_sum([
+
+    -19998
+  ]))))))) * ((sinh(-2222.9999) - (8.857897136797119e+06)) + $v0(/***/"J{\"key\":1}D\000"))))) - (-2222.9999),  ("qIM>\""), -52265, (33609), array(
+    (((string)(rawurlencode((string)((int)((((new Class4(0))->field11)
+      | ((true ? 54239 : (255)))) ** /*
+*/ ((int)(_safe_int_mod(((int)(-30966)), ((int)(-10412736745)))))))))) === ("|X<p>t/1\n2N[\"val\"]''Z</p>,0x1foiQ(GMx]X-123n``?ハロー・ワールド"))	=== (new Class5())->method6(/* comment */array_count_values(array(
+      ((int)(new Class5())->field10),
+			255,
+    ))), //
+    is_nan(Class1::$field3),
+    is_infinite( 23.14028703474397),
+  ),  -49591);
+  $v2 = "</p>";
+  /** @var (?float) $v3 */ $v3 = (73.05535316739024);
+  return 4.447069873716543e+06;
+
+}
+
+/**
+ * @param bool $p0
+ * @param int $p1
+ * @param Class1 $p2
+ * @param Class0 $p3
+ * @return Interface0
+ */
+function lib1_func2(&$p0, $p1, &$p2, $p3) {
+  if (!_visit_function(/***/'lib1_func2')) return null;
+  dump_with_pos(__FILE__, __LINE__, $p0);
+  $p0 /*
+*/ =
+    $p0;
+  $p2	= new Class1(); //*/
+  return new Class2((new Class2((-1) - /*
+*/ 2.51, function () {
+
+    return (bin2hex(trim("t,''C-''<h1>ok</h1>#N")) . ("1q 0x1f"));
+
+  }))->field2 + (floor((asin(/***/_safe_float_div(0.6372601815825947,  (Class1::$field3)),)))), function () use ($p0, /**/$p1) {
+    return (urlencode(("simple string")));
+  });
+}
+
@@ -0,0 +1,236 @@
+<?php
+/**
+ * @return (bool|Class1)
+ */
+function lib2_func0() {
+  if (!_visit_function("lib2_func0")) return null;
+  $v0 = -17422;
+  {
+		/** @var bool $v1 */ $v1 = is_nan((((true === (((is_file("h`ipODU|h ]ssimple stringAetYc,<div/>``+*(")) || (false)))) ? (11.590255784141911) : make_positive_inf())),);
+    $_iv2 = /**/ lib2_gen0( _safe_float_div(((new Class5())->field10 * 0.5495104704463337), /* comment */asinh( (round( (300.0507464052037 - (1.3622963193592886e+06  - (0.00043 * (new Class2( 0.0934290050563406, fn() => ",{\"key\":1}simple string"))->field2))),) - (round(_safe_float_div((10.209071195914023 + ((make_positive_inf()))), (2842.6378)), (int)((!is_nan(/* comment */make_positive_inf()) ? (((int)(-(strcasecmp("^0x1f", "C",))))) : crc32(/*
+*/'J') ^
+      (new Class4(18385144723))->field11))))) - 329.5),), 56241, /* comment */  new Class1(),  $v1);
+    while ($_iv2->valid()) {
+      dump_with_pos(__FILE__, __LINE__, $_iv2->key());
+      dump_with_pos(__FILE__, __LINE__, $_iv2->current());
+      dump_with_pos(__FILE__, __LINE__,
+        $_iv2->send(((int)(_safe_int_div(10759,  ((int)(true || (true || (true && /**/ (array_key_exists((("d<div/>{$v1}x{$v1}\000ハロー・ワールド")  . /* comment */ md5(":fRv!", false)), array_keys(array_filter(array(
+        ("\000"),
+      ), /* comment */function ($a0) use ($v0) {
+        return true || true;
+      }))) && true)))))))),));
+    }
+    dump_with_pos(__FILE__, __LINE__,	$_iv2->getReturn(),);
+    {
+      if ((file_exists(("0003.6#OO)xOkKh-123r DJu<div/>rj;Gハロー・ワールド6)\"D") /**/ .
+        md5("</p>lo4", $v1)))) {
+				$v3 =	tuple( (false), [
+					array(
+            $v1,
+            ((true)) || (((true)  ||	(false)) && (float_eq2( (2.51), 0.0023785442374705205))),
+						((Class1::CONST1)
+              || file_exists(implode("ハロー・ワールド-", array(
+              htmlentities(/*
+*/'</p>'),
+              Class5::CONST2,
+              ",^?F s-123\\UKi1(:Eoハロー・ワールド[aJ'^`",
+              ('%JA;') . ("<h1>ok</h1>,O&24YQ-123")
+            )))),
+            (((file_exists(("Dsimple string r{$v1}>I1\n23'' R)CP")) || (((!(!false)) ||  ((!true)
+              && ((false)  || false))) && true)) &&  (is_finite((-1),))) && (((false) ||	false) || /**/ is_infinite( (((is_writeable("kCLD<div/>[\"val\"]")) ? (new Class5())->field10 : -1) + 2.51),))) /***/ || /*
+*/ ((new Class5())->method6(array(
+              ((int)((((int)(_safe_int_mod((9846592291),
+                (56197)))))	+ /**/ ((Class3::CONST0) ^ ((int)("{$v1}\"# {$v1}\000{$v1}.<div/>y")))))
+            )))
+          ),
+					array(
+            (!((strcasecmp(implode(((string)true), [
+              "MM" . "Lx"
+            ]), (Class5::CONST2))) === ((int)(((int)(((int)(-((int)('c/<h1>ok</h1>t?]')))) ** ((Class3::CONST0) | ((count(array(
+              15634036672,
+            ),)))))) - ((int)((new Class2(21948.293242,  fn() => '=DU<h1>ok</h1>^'))->field2 + (58.327035853533694	- atan2(0.6524166705916641, 0.0)))))))),
+          ),
+          [
+            (!(Class3::CONST1)) || /*
+*/ (true || (is_nan(asinh( atan2(310.15375287056787, 0.0))))),
+            !(false),
+            $v1,
+          ],
+          [
+            !((is_infinite(9.656677225858655) || ((((!is_finite(21948.293242))  && /**/ false) &&  ((!(true)) || ((true || /***/ true)	&& ((-1) == 128412288)))) || (false ||	(is_writeable("){Zy" . /***/ "]L `Ra"))))) && (((false ||  false)
+              || false) && is_scalar(array(
+              ("R"),
+              (base64_encode("L<")  . ((string)true)), # comment
+            )))),
+            (false),
+						true /**/ || (new Class5())->method6(false),
+          ],
+        ],  new Class5(),  new Class3(), [
+          "1e3" => "S0x1fp",
+          "2" => ("<h1>ok</h1>"), //
+          '1' => ("\000" . "7<div/>Jf")
+        ], (24136),  $v1, -9284120);
+      }
+    }
+  }
+  return ((new Class0())->field3);
+}
+
+/**
+ * @param Class1 $p0
+ * @return array<mixed,bool>
+ */
+function lib2_func1(&$p0) {
+  if (!_visit_function("lib2_func1")) return array(
+    '-1'  => false,
+    "1.5" => false,
+    "01"  => false
+  );
+  /** @var (string|(float[])) $v0 */ $v0 = array(
+    0.4031809088257421,
+    (83.64336132757859 - /**/ ((cosh(1.2721927955610862e+06))
+      + ceil(/**/9.533516200584979))) - (((new Class5())->field10) + exp(cosh( floor(2842.6378)))),
+    6.274805369383546e+06,
+  );
+  dump_with_pos(__FILE__,	__LINE__, /**/ (((int)((strlen((strrev('X["val"]E')),))
+    +  (ord(((string)(_safe_float_div(99.84488788528161, (atan2((408.0938041371212 + 717.9827749478445) - 752.8282063068266,  /*
+*/0.00043) - (0.0))))))))) | ((int)(-(-13591)))));
+  try {
+    if (Class3::CONST1) {
+      switch (make_positive_inf()) {
+        case 138.71499528532877:
+					/** @var (?(bool[])) $v1 */ $v1 /*
+*/ = null;
+          $v2 =  '<div/>';
+        case 329.5:
+          /** @var bool $v3 */ $v3 = ((file_exists(gettype(((new Class5())->field10)))) || (new Class0())->field3);
+          $_iv4 = 0;
+          while ($_iv4++ <	1) {
+            $p0->field1 = $p0;
+            $p0->field1 = $p0;
+            $v5 = "l4_</p>000simple stringハロー・ワールド''{$v3}</p>";
+          }
+
+          break;
+        case make_negative_inf():
+          $p0->field1 = new Class1();
+          break;
+        case 21948.293242:
+          dump_with_pos(__FILE__, __LINE__, /*
+*/[
+            (count(array_flip(array_flip([
+              ((new Class5())->method6((false || /* comment */ (!("<4"
+                == ("K")))))),
+              strcmp(";ハロー・ワールドハロー・ワールド4</p>O,<p>", "skVF+:\000M=zNS[&A0x1f"), //
+            ])))),
+            ((int)(ord(((string)(is_string($v0) ? $v0 : "2;</p>simple string_2(L].OI)%'']]AXsimple stringtx-")) . ((string)(is_dir("E)W\000<div/>[1\n20v}qwd2'' _F|{j")	&& (!((new Class5())->method6( false) || (!true)))))) /**/ - ((int)2.0664652679488054e+06))) | (Class3::CONST0),
+            ((int)((strcasecmp((string)(is_string( $v0) ? $v0 : ((string)("24<h1>ok</h1>1\n2Jx#"))),  '24'))
+              ** ((int)(-(-9284120))))),
+          ]);
+          $v6 = new Class3();
+          break;
+        case 78.85394393003234:
+          /** @var (?Class3) $v7 */ $v7 = new Class3();
+
+          /** @var (?Class0) $v8 */ $v8 /***/ = null;
+          break;
+        default:
+          $v9 = (int)(int)(((int)(-(-57241))) - (strcmp((string)(is_string($v0) ? $v0 : ((Class1::CONST0))), Class5::CONST2)));
+          /** @var callable(bool,string,float):bool $v10 */ $v10 = fn($a0, $a1, $a2) => (false) || (is_nan($a2));
+      }
+		}
+		throw new Exception2((("!ハロー・ワールド|e</p>U7Bj1\n20x1f 4O%a_P3MM") . ("rd04En/.E z<h1>ok</h1>``]u``,{\"key\":1}3 ],\000"))
+      . /***/ urldecode((string)((array_key_exists((new Class3())->field4,
+       array_keys(array(
+			298.67869945874673,
+      is_readable(/* comment */'n|\'\'F{'),
+      cosh(792785.315873308),
+		)))) && ((new Class5())->method6(array(
+      (int)(_safe_int_div((((int)15319200065) | (count(array(
+        make_nan(),
+      )))), (ord("/[\"val\"]5\000a")))),
+      (int)true,
+      1150835698,
+    ))))), -55273);
+
+  } catch (Exception2 $_iv11) {
+    dump_with_pos(__FILE__, __LINE__, get_class($_iv11) /**/ . ': ' . $_iv11->getMessage());
+    /** @var callable():int $v12 */ $v12 = fn() => strnatcmp(/***/("0x1fo``yJ"), stripslashes("1\n28rOb|%g7{\"key\":1},000fF")) ^ 801065525;
+    throw $_iv11; // comment
+  } finally {
+    list($v13, $v14, $v15, $v16, $v17) = tuple(null, ((new Class3())->method0(true,  (levenshtein("24", /***/gettype(array(
+      ((!false) || true)
+        ||	true,
+      (Class1::CONST1) && (((new Class0())->method3()) /***/ || (!true)),
+      (!(",{" === "l}h\000r")) == (Class3::CONST1),
+      true,
+    )))), /***/ (30365))), new Class6(),  new Class5(),  '24');
+
+    dump_with_pos(__FILE__, __LINE__, /**/ /* comment */$v13);
+		dump_with_pos(__FILE__,	__LINE__, /***/$v14);
+    dump_with_pos(__FILE__, __LINE__, /**/$v17);
+  }
+  $p0 = $p0;
+  if (float_eq3(((make_nan()) +  0.8653686364783382),	(make_negative_inf()))) {
+    throw new Exception1(strtolower("AL!"), -49241);
+  }
+	return [
+    "-1" => ((!false) == (true || (is_infinite((-1))))) && ((new Class0())->method3()),
+    "01" => (new Class0())->field3,
+    5
+      => ("-123r<\000[\\LSA=W)") == (((string)(is_string( $v0) ? $v0 : "zw{\"key\":1}</p>=24{\"key\":1}1Zeハロー・ワールド=\"")) . (("XJC0x1f%=di?0x1f,?``^t=80x1f4a</p>\\Hfrx\\8(d"))), // comment
+  ];
+}
+
+/**
+ * @param Class2 $p0
+ * @param (string[]) $p1
+ * @param Class5 $p2
+ * @param (?string) $p3
+ * @param string $p4
+ * @param (?int) $p5
+ * @return Class0
+ */
+function lib2_func2(&$p0, $p1, $p2, $p3, $p4, $p5) {
+  if (!_visit_function( "lib2_func2")) return null;
+  dump_with_pos(__FILE__,  __LINE__, -2222.9999);
+  try {
+    return new Class0();
+  } finally {
+		dump_with_pos(__FILE__, __LINE__, "lib2_func2 finally");
+    return new Class0();
+  }
+}
+
+/**
+ * @param float $p0
+ * @param int $p1
+ * @param Class1 $p2
+ * @param bool $p3
+ * @return Generator<string,bool,int,string>
+ */
+function lib2_gen0($p0, $p1, $p2, &$p3) {
+  if (!_visit_function( 'lib2_gen0',)) return "9Z-123";
+  $v0 = 0.6529690752964;
+  $v1 = tuple(new Class0());
+
+  yield (Class5::CONST2)  =>	$p3;
+  $p3 = (!(checkdate((strlen((("-123") . (md5("{$p3}{\"key\":1}''xg{$p0}!1\n27A{$p3}r>wF")))) ^ strcasecmp(implode( chr((int)(0 ^ (-3112)),), array_map(function ($a0) {
+    return $a0;
+  }, /**/array(
+    (lcfirst('24')),
+    (ucfirst("000`")),
+    "[\"val\"]24wXb=Qs{$p3}{$p0}QKDz11\n2 1'(F",
+    ("a7,-123")
+  ))), urldecode((string)json_encode((deg2rad($p0)))),)), (int)(_safe_int_div(((int)(-(-1))), /**/ (Class3::CONST0))), sizeof(/*
+*/array(
+    (true),
+    (((float_eq3((new Class0())->field2, (tan(870.4571163316917))) ? acos(/*
+*/947.015581386304 /**/ * 0.19023312543965415,) : ((_safe_float_div(/* comment */((-2222.9999) -
+      190.82630315709793), (asinh(25.45475105091049)))) +	(_safe_float_div((false ? -2222.9999 : (2.51)), cosh($p0,),))))) * pi()),
+    is_scalar(0.483397326397202),
+    ("_9?"),
+  )))));
+  return ((string)(!(((new Class0())->method3()) || false) ? ((int)(-((int)(-(5106322720))))) : ((int)((!is_writeable("<d")) && (((true) || false) && (!checkdate(((new Class4(51111))->field11),	((int)(acos(0.1278617793109339)	* /***/ (cosh($p0)))),  (int)(-strlen(';5000E-S',)))))))));
+}
+
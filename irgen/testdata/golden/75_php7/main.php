@@ -0,0 +1,199 @@
+<?php
+require_once __DIR__ . '/fuzzlib.php';
+require_once __DIR__ . '/Interface0.php';
+require_once __DIR__ . '/Interface1.php';
+require_once __DIR__ . '/Class0.php';
+
+require_once __DIR__ . '/Class1.php';
+require_once __DIR__ . '/Class2.php';
+require_once __DIR__ . '/Class3.php';
+require_once __DIR__ . '/Class4.php';
+
+require_once __DIR__ . '/Class5.php';
+require_once __DIR__ . '/Class6.php';
+require_once __DIR__ . '/Exception0.php';
+require_once __DIR__ . '/Exception1.php';
+require_once __DIR__ . '/Exception2.php';
+require_once __DIR__ . '/lib0.php';
+require_once __DIR__ . '/lib1.php';
+require_once __DIR__ . '/lib2.php';
+function func0() {
+  /** @var (?(int[])) $v0 */ $v0 = array(
+    (((((new Class5())->method6(/**/array_map(/*
+*/fn($a0) => (strcasecmp("ao" .
+      "z']hH", (substr_replace($a0, "aH", /*
+*/ (int)(-1))))), /***/str_split((substr_replace( '3' .	"6!''D",	(string)json_encode(true),	 (int)((int)(6615983298 /***/ - (-9284120))),)), ((int)('</p>(simple string'	.  "000")) ^ ((int)(((128412288 | (-23781))) * 0))))) &&  ((!(!(new Class5())->method6((false)))) ==
+      ((new Class0())->method3()))) || (((!((((false) || true))  &&
+      (base64_encode('cVB,h') ===  ("3R?k")))) && (true)) && (!(((((!(float_eq2( 0.17914299476408302, 4.529389305418118e+06)))	|| (false))	|| (!((((true) /* comment */ && false)) &&  (true && false))))) ||
+      (false))))) || (((int)(((int)(_safe_int_div(21238, ((int)crc32("-123"))))) -	crc32(((string)((new Class0())->field3))))) === ((int)(_safe_int_mod(48747, (-51962)))))) ? (int)(62436 **	((int)(-((((int)(count(array(
+      ('0x1f'),
+    )) + strcmp(("</p>.VUA?E''<h1>ok</h1>@icP*dEO<div/>0x1f6<div/>T''"), /***/("ZH<h1>ok</h1>"
+      .  implode(/*
+*/":r",  array(
+			'?hDH',
+      "``"
+    )))))) ^ (-65288)) ^
+			((int)(_safe_int_div((strnatcmp('Z 7dx>', "d>*U<p>^\\O1\n2^9>eP1\n2<h1>ok</h1>>3E#V<div/>")), ((int)false),))))))) : (new Class4((int)(_safe_int_mod((-1804), ((int)(((int)((-1) - sizeof( array(
+      (int)(-(-59652)),
+      "Ac*+;\000`D0>}"
+    ))))
+      - (crc32("sW"))))))))->field11),
+    (Class3::CONST0),
+    ((file_exists(/*
+*/(implode( ((string)("uD+K]\000K0x1f+%l{\"key\":1}^")),
+      array_filter(str_split((ucfirst(("Jz``:simple string<p>simple stringk[\"val\"]g<div/>h:I~<h1>ok</h1>t:0\"H")))), fn($a1) => ("[\"val\"]" /**/ .	("Uf" .	'V<p>000I1^')) === ($a1)),)))) ? ((int)(-strnatcmp((dirname(("-123j"))), /*
+*/ rawurlencode("@X24pH\"W3e-123")))) : ((-255))) /*
+*/ ^ ((int)(-(-9284120))),
+    ((int)(((int)(((int)(((int)(((int)("-1236_\"ITsj)0001\n2p24''T zハロー・ワールド@")) * /* comment */ ((((int)(Class1::$field3))) ^ ((int)((-9284120) - ((int)(-((int)(((int)((-55922) + (crc32("+")))) ** ((int)(Class1::$field3))))))))))) - (34353)))
+      + ((int)(-(Class3::CONST0))))) - /* comment */ ((int)((-255) ** 61042))))
+  );
+  $v1 =	array(
+    (!((new Class0())->field3)),
+		((new Class5())->method6(!is_finite(((2.6465298078979934e+06) * (((make_negative_inf()) +  (sin(723.9149147677069))))))) && ((((int)(((int)(_safe_int_mod(/**/((((int)((Class3::CONST0) - ((int)(_safe_int_div(crc32("("),  ((int)(_safe_int_div( (-19580),	56225)))))))) == (-1)) ? ((-2444) ^ 2062038059) : (4141066328)),
+      strcmp("~>3>CI", ("G\000;jA`simple string#''2-123Dy000Bl_0x1f"))))) - ((int)(-(-59037)))))	== (-40354)) || (!(is_file(/*
+*/(("'g( ``,;S") . ",")) === (new Class0())->method3())))),
+    is_finite(/*
+*/((2.51) - (((checkdate(crc32(("]q|w\000Y]0x1f0x1fハロー・ワールド:!H;Q *00061\n22''0x1f{\"key\":1}")), /***/(crc32((string)(-255))),  (int)(((int)(_safe_int_mod(((int)(8799611611 * (-34811))), /* comment */strlen('<%h',)))) -	((int)((14042272998) **
+      (-62315)))))) ===	true ? (-1) : ((new Class0())->field2))))),
+    (true)
+  );
+  $v2 = "</p>";
+  /** @var ((bool[])|bool) $v3 */ $v3 = $v1;
+  /** @var bool $v4 */ $v4 = (is_nan(18.420696779353985 - 2842.6378));
+  $v5	= new Class4((int)(strnatcmp("`,NH",  (string)strcasecmp($v2, $v2)) ** ((int)(Class3::CONST1))));
+	{
+    dump_with_pos(__FILE__, __LINE__, 17128569948);
+    dump_with_pos(__FILE__, __LINE__, true && (!(Class3::CONST1)));
+    dump_with_pos(__FILE__, /**/ __LINE__, "simple string");
+  } # comment
+  dump_with_pos(/**/__FILE__, __LINE__, (int)((-13325) **	strnatcmp("|,zP",	$v2)));
+  foreach ($v1 as &$v6) {
+    $v6 = $v4;
+  }
+  unset(/* comment */$v6);
+  foreach ($v1 as $v6) { //*/
+    dump_with_pos(/* comment */__FILE__, __LINE__,
+      $v6);
+  }
+  dump_with_pos(__FILE__, __LINE__, $v1);
+  $v7 = new Class6();
+	$_iv8  = 0;
+  while ($_iv8++ /**/ <
+    (10)) {
+
+    $_iv9 = 0;
+    while ($_iv9++ < 1) {
+      dump_with_pos(__FILE__, __LINE__, "-{\"key\":1}:Gt");
+      /** @var (string|Class5) $v10 */ $v10 = (rtrim(((new Class3())->field4), $v2));
+    }
+    $_iv11 = /**/ 0;
+    while ($_iv11++ <  1) {
+      dump_with_pos(__FILE__, __LINE__, [
+        2 => floor((3.117408061137224e+06 - 180.3118619423831)) - 0.4512289035658131,
+        -1 /***/ => (0.9365245707181201 - (((154.71482185954432) -	0.03187880037382764) + (atan2(636.0074573861616, acosh(3.3860103408427257e+06))))),
+
+        5 => ((28.39948381348394) + ((atan(351.1467159436029)) -  ((new Class0())->field2))),
+      ],);
+    }
+    dump_with_pos(__FILE__, __LINE__, /**/  make_negative_inf());
+  }
+  dump_with_pos(__FILE__, __LINE__,  $v0);
+  dump_with_pos(__FILE__,  __LINE__, /**/ $v1);
+	dump_with_pos( __FILE__, __LINE__, /**/$v2);
+  dump_with_pos(__FILE__, __LINE__,  $v3);
+}
+
+function func1() {
+  $v0 =	tuple((13.598133875016712), strcmp(("i8mV%+Ux?4_0x1f1\n2")	. ("I" . (("F``B0x1f=''4/ 1\n2~!V<h1>ok</h1>m R``b") . /**/ ("8`"))), "simple string%''y aS</p>\"2</p>u|000-1231\n2gx000{\"key\":1}R1\n2Jsimple string1",), fn($a0, $a1, $a2) => "JJ", Class3::CONST1,	 "''", Class3::CONST1, /**/",-)ハロー・ワールド.", new Class5(), (255), /*
+*/(false), tuple("<p>", 41489, new Class4(6067), new Class3(), (!((new Class0())->field3)),), (("<h1>ok</h1>``[\"val\"]<div/>") /*
+*/ . ucwords((new Class5())->field4))  . htmlentities(("}24``{3k")));
+  $v1 = array(
+    "01" => (strnatcmp('f>',	/**/(""))) // comment
+  );
+	$v2 = array( # comment
+    ((new Class4(((int)(strcmp((string)true, "\000a1\n2 [\"val\"]0x1fハロー・ワールド/#",)	** /**/ ((true) /* comment */ || false ? ((int)(-(crc32(("k\000[\"val\"],osハロー・ワールド, ''"))))) : (8259526275)))),))->field11) | 61224,
+    count(array(
+      (make_negative_inf()),
+      0,
+      0.00043
+        - (sin((((new Class2(2842.6378, fn() => "24",))->field2 - (0.28523965702598686 * 1.5706615707555453e+06))) + (make_nan()))),
+      ((is_scalar(array(
+        (!true)
+      ),)) || ((((false /***/ === false) && /*
+*/ (Class1::CONST1)) /***/ || true)
+				|| true)) ===
+        (false),
+    ))	^
+      ((int)(-count([
+      (9284128 | (23774)),
+      (Class1::CONST1)  ||	(!(!(float_eq2((0.1728099507198673 + (-2222.9999)), ((false ? (204.7639062466598) : (-1))))))),
+      " 53ハロー・ワールド0x1fVk24AZ'",
+    ]))),
+  );
+  $v3 =  21948.293242;
+  /** @var (?float) $v4 */ $v4 = exp(deg2rad(0.15370627321713223));
+
+  /** @var callable(string,string):string $v5 */ $v5 = fn($a3, $a4) => $a4;
+
+  foreach ($v2 as $v6
+    => $v7) {
+    dump_with_pos(__FILE__,  __LINE__,  $v6);
+    dump_with_pos(__FILE__,  __LINE__,  $v7);
+    $v8 = tuple(function ($a5, $a6) use ($v7) {
+      return (Class3::CONST0) ^ ((int)(-(-27387)));
+    }, -2222.9999, "r",	" ,9", -9284120, new Class4( $v7), make_positive_inf());
+  }
+  foreach ($v2 as &$v9) {
+    $v9
+      =	strcasecmp(("Gdj|[\"val\"]" . ("`w\\Rxhlz?o24")), (gettype(((string)(((int)((-51331) - (((((!false) == (true))
+      && /**/ (false ||  (new Class0())->method3())) ? (-29438)
+      ^ ((int)(_safe_int_mod(/*
+*/((int)false), ord( '<p>\\/t/')))) : ((int)(((strcmp("MT=_\"", /* comment */"''O{<div/>"))) -
+      (-15432))))))) === ((int)(_safe_int_mod(((int)(-((int)(-((int)(((int)(_safe_int_mod(/* comment */8475199434,
+      (-9284120)))) + (-255))))))),  ((int)(((int)(-(((int)(0 + 0)) ^ (0 & 9284128)))) -	sizeof(array(
+      -10750,
+    ))))))))))));
+  }
+  unset(/**/$v9);
+  foreach ($v2 as $v9) {
+    dump_with_pos(__FILE__, __LINE__, $v9);
+  }
+  dump_with_pos(__FILE__,  __LINE__,  $v2);
+  /** @var (int|Class0) $v10 */ $v10 = new Class0();
+  /** @var ((float[])|Class1) $v11 */ $v11	= [
+
+    (-1),
+  ];
+  $v12 =  array(
+    '1 ' => !(Class1::CONST1),
+    '0x1'  => Class3::CONST1, //
+  );
+  /** @var (bool|float) $v13 */ $v13 = (-1);
+  dump_with_pos(__FILE__,  __LINE__, $v1);
+  dump_with_pos( __FILE__, __LINE__, $v2);
+  dump_with_pos(__FILE__, __LINE__, $v3);
+  dump_with_pos(__FILE__, __LINE__, $v4);
+}
+
+function main() {
+	try {
+    func0();
+  } catch (Exception $e) {
+    dump_with_pos(__FILE__,  __LINE__, /**/(get_class($e)
+      . ": ") .	$e->getMessage());
+  } catch (TypeError $e) {
+    dump_with_pos(/***/__FILE__,	__LINE__, /***/get_class( $e)); # comment
+  }
+  try {
+    func1();
+  } catch (Exception $e) {
+    dump_with_pos( __FILE__,  __LINE__, get_class($e)
+      . ": "
+      . $e->getMessage());
+  } catch (TypeError $e) {
+
+    dump_with_pos(__FILE__,  __LINE__, get_class($e,));
+  }
+}
+
+main();
@@ -0,0 +1,188 @@
+<?php
+class Class0 {
+  const CONST0 = "H}24F";
+
+  const CONST1 = 2881634763;
+  /** @var callable(string,bool,bool):bool */
+  public $field0;
+  /** @var string */
+  public static $field1 = "I";
+  /** @var float */
+  protected $field2;
+
+  /** @var (?(float[])) */
+  protected static $field3;
+  /** @var string */
+  protected $field4 = "9Q`";
+  /** @var string */
+  public $field5 = "{\"key\":1}";
+  /** @var (int[]) */
+  protected $field6;
+  /** @var int */
+  private $field7 = 40403;
+  /**
+	 * @param string $p0
+   * @param array<string,int> $p1
+   * @param (bool|(bool[])) $p2
+   * @return tuple(Class6,float,int,bool,bool,string,string,(?int),bool,tuple(float))
+   */
+  public function method0($p0, &$p1, $p2) { //
+    if (!_visit_function( 'Class0::method0')) return tuple(null, 0.0, /* comment */-1, false, false, /* comment */'.@["val"]P6^', "l]", 221821143, /**/ false, tuple(208.81236068376703));
+    $v0 = new Class5(function () use ($p0, /**/$p1, $p2) {
+      return ((int)((-34865) - 7760)) |
+        128412288;
+    }, 329.5);
+    try {
+      $v1 /***/ = new Class6((Class5::CONST0) || ((!(float_eq3((329.5 * (new Class2("0x1f", "<h1>ok</h1>Zt `0x1f%''j,0x1fJcV{\"key\":1}<div/>Q#``{\"key\":1},%u,"))->method1(new Class1((int)(-(-27041))), [
+        "0x1" => ((false) && (true)) ===  (true  || true),
+
+        "b"
+          =>
+          true,
+        '1 ' => (!(is_array($p2))),
+      ],
+				null)), (_safe_float_div((20.133964203898795), (((107.44332428886183)) -  $this->field2)))))) &&
+        ((false) && (((47094)
+				== (new Class4(function () {
+
+				return (new Class2("0x1f", "=.1\n2e0x1f`"))->method0();
+      }))->field2)))));
+      /** @var (?float) $v2 */ $v2 = (21948.293242 + (((0.0) + (21948.293242)) - lib1_func2((is_writeable((ltrim(stripslashes("J"))))) /**/ ||  ((static::CONST1) === (-49775)), $v0, 0.00043, /**/ (false), tuple(function ($a6) use ($p2, $p0) {
+        return is_scalar(array(
+          acosh(5.739217200894896e+06), //
+        ));
+			}, new Class6((!(!((false && (true &&
+        true)) == ((true &&  true)  && (true &&
+        false)))))),
+        new Class1(sizeof(array_keys(/*
+*/array_keys(array(
+        -1
+      ))))), $this->field4, addslashes( ("-123XjRE~_ハロー・ワールドX{\"key\":1}c}^8oC<p>1\n29")),
+        tuple($v0, '} `(G', !((!((true) === (true && false))) &&
+        (((true	=== false) && ((bool)(is_bool( $p2,) ? ($p2) : true))) && (!(128412288 /***/ === /***/ 128412288)))))),  function ($a7, $a8) use ($v0,
+        $p2) {
+        return (!(new Class6($a8))->field7);
+      }, new Class6( ((int)((sizeof([
+
+        Class4::CONST2,
+        true,
+      ]))
+				* (strlen( "\\'-1231R%J")))) === ((int)(!(!(is_array($p2))))),), tuple(0.21124098589828294, new Class0(), new Class3(),  sizeof([
+        Class5::CONST0,
+        (Class1::CONST0),
+        661416.2882892564,
+      ]), function () use ($v0) {
+        return (int)(-(255));
+			}), /***/329.5)));
+      /** @var ((float[])|bool) $v3 */ $v3
+        = array(
+        ((((true
+					&& is_readable('o\'\'\\++l',)) ? (make_positive_inf()) : sin((float)($v2 !== (null) ? ($v2) : (21948.293242)))))	+ 0.0)	+ (asinh(329.5)),
+				((float)(is_null($v2) ? ((make_negative_inf())) : $v2)),
+        (((sinh((round(/*
+*/(fmod( pi(), $this->field2) + (((new Class6(false))->field7) ? (((make_nan()) + 0.00043)) : ((new Class0())->field2))),  (int)sizeof(array(
+          ($v1->field7 && ('z' == "y")),
+          (ucwords( ' LaQ')),
+          make_negative_inf(),
+          (float_eq2(sin(2.51), atan2(4.337296093044993e+06, make_nan()))),
+        )))),)))	*
+          ((new Class7())->field13)),
+      );
+    } catch (Exception0 $_iv4) {
+      dump_with_pos(__FILE__, __LINE__,	get_class(/***/$_iv4)  . ": " .
+        $_iv4->getMessage());
+      throw $_iv4;
+    } catch (Exception0 $_iv5) {
+      dump_with_pos(__FILE__, /***/ __LINE__,  get_class( $_iv5)  .	": "  . /**/ $_iv5->getMessage());
+
+      throw $_iv5;
+
+    } catch (Exception $_iv6) {
+      dump_with_pos(__FILE__,
+        /* comment */__LINE__,  get_class($_iv6) . ': ' . $_iv6->getMessage());
+      $this->field2 =  ((((false && true) || (!(($v0 instanceof Class5))))
+				== (is_file(base64_encode(' ["val"]Mpe9'))) ? (276.7332120638029) +
+        (0.3447266528329321) : (deg2rad(ceil(/*
+*/(((_safe_float_div(543152.73489305, /* comment */ floor(0.5530493133369639)))) +
+        (-1))	- (atan2( (50.81203178107495), make_positive_inf() + (4.276474156345834e+06 * 0.0)))))))) -  sqrt( (new Class1(9284128))->field4);
+    }
+
+    return tuple( new Class6(((((!(('te') === ("<h1>ok</h1>x:a''[.#1\n2t%24^+")))
+      && (true)) && (Class5::CONST0))	== (!((!false) || true))) == (((((!is_dir(/***/"[\"val\"]i3L^T\"ハロー・ワールド3U-n1\n2F<div/>Q1ハロー・ワールド")) ||
+      true) /***/ || ((is_dir((string)(-2222.9999),)) && ((!((new Class6(true))->field7)) && true)))) || (is_dir(("] U\000q?_e-1232424 J"))))), _safe_float_div(((new Class0())->field2), ((make_nan()) * 21948.293242)), /*
+*/((int)(strtolower("i{\"key\":1}><h1>ok</h1>8>qi"))),	(is_finite((0.5250321617789797))) &&
+			(float_eq2( (_safe_float_div((431.02539367825267 *  (_safe_float_div((((new Class2('N000;>V', "X[-123gv"))->method0()) - 624.0146814985579), /***/(new Class0())->field2))), /***/2.51)), (pi()))), true, "1\n2", /* comment */ (stripslashes("''<" . stripslashes((("[\"val\"]M" . ((Class7::CONST2) . ("%<div/>O"))) . /***/ "``") .	(new Class7())->field14))), null, /* comment */ /**/((0)
+      ===	18471327489) == ((new Class6(((bool)(is_bool($p2) ? ($p2) : (true)))))->field7), tuple(((((!is_dir("``" . dirname("\000K:\"}"))) || ((((true) || ((!(false && false)) &&
+      (false  || ((true &&  false))))) || /***/ (('qハロー・ワールド' === (strtoupper("-P") . (" zJ"))) && (!(float_eq3(2842.6378, (2.126471349218629e+06 + 21948.293242)))))) || /* comment */ (!(((bool)(is_bool($p2) ? ($p2) : ((true &&
+      true))))
+      ||
+			(true)))))
+      && (false ||
+			false)) ? (329.5) : (make_nan()))),);
+
+  }
+
+  /**
+   * @param callable():int $p0
+   * @return string
+   */
+  public function method1($p0) {
+    if (!_visit_function("Class0::method1")) return "ハロー・ワールド";
+    /** @var callable(float):float $v0 */ $v0 = function ($a0) use ($p0) {
+      return 10.361761161821635; //
+    };
+    $v1 =  array_count_values([
+      (true),
+      ((new Class5(function () use ( $v0, /**/ $p0) {
+        return strlen(strtoupper( "Y") . rawurldecode("[\"val\"]"),);
+      }, /***/ ((new Class1((-255)))->field4)))->method0()),
+      0.0, # comment
+    ]);
+    foreach ($v1 as &$v2) {
+      $v2 /***/ = (int)(((int)(-((int)"``"))) + (-31782));
+
+    }
+		unset($v2,);
+    foreach ($v1 as $v2) {
+      dump_with_pos(__FILE__, __LINE__, /***/$v2);
+    }
+    dump_with_pos( __FILE__, __LINE__, $v1,);
+    return (("000w<div/>D3DZ224Fハロー・ワールド6ub_\\!<p>")  . ((md5(addslashes(((",\0001Bcy''``IU_1\n2JXl</p>~") . ("<h1>ok</h1>A<div/>Q{\"key\":1}H,D245s"))) .  ("u9")) . (':<div/>i"Ur')) . (new Class7())->field5)) . ((string)(chr( (int)(Class6::CONST0)) /**/ . ((((string)((string)"-123 ")) .  ("''6ハロー・ワールドZU 2")))));
+
+  }
+
+  /**
+   * @param callable(string):string $p0
+   * @param (?int) $p1
+   * @param (bool|float) $p2
+   * @return int
+   */
+  public function method2(&$p0, $p1, $p2) {
+    if (!_visit_function(/*
+*/"Class0::method2")) return -1;
+
+    /** @var (float|int) $v0 */ $v0 = (Class4::CONST1);
+    $v0 = ((int)(-((int)(-((int)(_safe_int_mod((new Class7())->method2($p0, $p1,	array_key_exists("<div/>" /**/ . "\000m9+p", array(
+			array_filter(array(
+        (rawurldecode("``")),
+        "``simple stringROk000simple string@,l<(G ",
+        (string)false,
+        (new Class7())->field14
+      ), fn($a0) => (false) && (float_eq2((-1),  68276.14344297031))),
+    ))), strcmp("uacTQ0000x1f0x1fO+1\n2&O", (string)atan(-1)))))))));
+    dump_with_pos(__FILE__, __LINE__, null);
+    $p0 = fn($a1) => ((new Class7())->method4([ # comment
+      "simple string+-123",
+			"YCRWa",
+      "n0S30E",
+			"YCRWa",
+    ], fn($a2, $a3, $a4) => ((int)(-levenshtein("A"	.  "0x1f<Q+", stripslashes((string)((float)(is_float($p2) ? ($p2) : -1)))))),));
+    try {
+      return (int)((((int)(((int)(ord(("#]K")) - ((int)(-((int)(-(strlen(("w<div/>000,}"))))))))) ** ((int)('X@?')))))  + (-59952));
+    } finally {
+      dump_with_pos(__FILE__, /*
+*/ __LINE__, "Class0::method2 finally");
+    }
+  }
+
+}
@@ -0,0 +1,345 @@
+<?php
+class Class1 {
+  const CONST0 = 9284128;
+  /** @var string */
+  public $field0 = "<p>v~F``";
+  /** @var (bool|string) */
+  protected $field1 = "3";
+  /** @var (Class7|string) */
+  public $field3 = null; //*/
+  /** @var float */
+  public $field4;
+  /** @var (?(int[])) */
+  private $field5 = [ //*/
+    -9284120,
+		-1,
+    -9748,
+		25436
+  ];
+  /** @var int */
+  protected $field6 = 15475;
+  /**
+   * @param int $field2
+   */
+  public function __construct(public $field2) {
+  }
+
+  /**
+   * @param Class1 $p0
+   * @param float $p1
+   * @param (?string) $p2
+   * @return int
+   */
+  public function method0($p0, $p1, &$p2) {
+    if (!_visit_function('Class1::method0')) return 2340;
+    $v0 = /*
+*/ new Class3(); # comment
+    /** @var (?(float[])) $v1 */ $v1 = array(
+      (0.0),
+			_safe_float_div(0.00043, (array_sum([
+
+				" mY H",
+      ]))),
+      ((make_positive_inf()) + 610.6104492396164),
+    );
+		try {
+      return 0;
+    } finally {
+      dump_with_pos(__FILE__, __LINE__, "Class1::method0 finally");
+		}
+  }
+
+  /**
+   * @param callable(int,int):string $p0
+   * @param (string[]) $p1
+   * @param float $p2
+   * @param Class0 $p3
+	 * @param string $p4
+   * @param Interface0 $p5
+   * @return Class0
+   */
+  public function method1(&$p0, &$p1, &$p2, $p3, $p4, $p5) {
+    if (!_visit_function("Class1::method1")) return null;
+    $v0 = [
+      '0x1' => tuple((255),  new Class6(!(is_infinite((2842.6378))),)), // comment
+      '01'
+        => tuple(((int)((47567) + (9284128))), /* comment */new Class6(((!(true  || (is_infinite( -2222.9999)))) ||
+        ((!(true)) || lib2_func3((int)(_safe_int_mod(((int)(((int)(((int)(_safe_int_mod((-1),  (-33019)))) ** (-15555))) +	((int)(((int)((-1) * /*
+*/ 128412288)) **
+				levenshtein('</p>24\'\'', /***/ $p4))))),  ((int)".f9"))),
+        fn($a0, $a1, $a2) => $a1,
+        $p3, (make_nan()) + (0.4245683456945529), null, (int)(((int)(_safe_int_mod((static::CONST0), 36496))) /***/ *	(128412288 &	((45853) & (levenshtein("0x1fF|", "1\n2"))))), static::CONST0))))),
+      '01' => tuple(((int)(0.33574912467093343)),  new Class6(!(float_eq3(rad2deg((563.5416538485972)), (make_negative_inf()),)))),
+    ];
+
+    switch ((addcslashes($p4, /**/"<h1>ok</h1>",))) {
+
+			case ("0x1f>S"):
+				/** @var callable(int,int):string $v1 */ $v1 = function ($a3, $a4) use ( $p2, $p4) {
+          return ("{\"key\":1}-123_/'CNc<h1>ok</h1>d <p><{$a4}");
+        };
+        /** @var callable(int):string $v2 */ $v2 = fn($a5) => $p4;
+        break;
+      default:
+    }
+    dump_with_pos(__FILE__, __LINE__, Class6::CONST0);
+    $p1  = $p1;
+    $p2 =	469325.5645609195;
+    return new Class0();
+  }
+
+  /**
+   * @param float $p0
+   * @param string $p1
+   * @param (?(int[])) $p2
+   * @param Interface0 $p3
+   * @param (int[]) $p4
+   * @param string $p5
+   * @return callable(int,string,int):bool
+   */
+	public function method2(&$p0, $p1, $p2, $p3, &$p4, $p5) {
+    if (!_visit_function('Class1::method2')) return fn($a0, $a1, $a2) => false;
+		$v0 = new Class6((is_scalar(/**/((int)(-((int)(-(new Class1(sizeof(array(
+      true
+    )) &	(Class4::CONST1)))->field2))))) /* comment */ && (!(!checkdate( ((int)(((int)(((47999)
+      | (0)) /***/ - (crc32(/**/$p5)))) + (((int)(((int)(_safe_int_mod(/**/((int)(_safe_int_mod((-9284120), 2438683720))),
+      (0)))) /***/ - ((int)(((3573)) - /*
+*/ count(array(
+      false
+
+    )))))) ^ levenshtein("a0:y\000<h1>ok</h1>",	$p5)))), ((((!((false || true) &&
+      false)) /* comment */ ||	((Class5::CONST0) /***/ && ((-3917) == ((int)((-1) ** /**/ (-65241)))))) ? (crc32((";V#:sS-123\000000R^</p>\000{\"key\":1}"))) : ((int)(_safe_int_div((Class0::CONST1),
+      ((int)((-9284120) * crc32($p5))),))))), ((int)(((int)(((int)((-1) * (9284128))) + ((int)(317.72469244498006))))
+      - (new Class5(fn() => 128412288, 2842.6378))->method0())))))));
+    dump_with_pos(__FILE__,  __LINE__, iterator_to_array(lib0_gen1(),	false));
+    /** @var (?string) $v1 */ $v1 = '["val"]c<div/>';
+    if ((is_infinite(623.4197801020235 * (round(/* comment */round(2842.6378) /**/ - (acos((0.0))), (int)((int)(((int)((-9284120) ** ((int)(levenshtein(((new Class0())->field5),
+      $p5,) * ((false ? strnatcmp("simple stringX</p>`S",  'simple string<p>') : (int)24234))))))  + 18624634198))))))) {
+      throw new Exception0((string)($v1 ?? ((string)($v1 ?? "h"))), -45955);
+    }
+    return fn($a3, $a4, $a5) => (true);
+  }
+
+  /**
+   * @param (string|float) $p0
+   * @param Class2 $p1
+   * @param Class1 $p2
+   * @param (Class5[]) $p3
+   * @param float $p4
+   * @return Class0
+   */
+  public function method3($p0, $p1, $p2, $p3, $p4) {
+    if (!_visit_function("Class1::method3")) return null;
+    /** @var (?string) $v0 */ $v0
+      = ("0x1f?d</p>1\n2|<p>`");
+    $_iv1 = 0;
+    while ($_iv1++ < /***/ 6) {
+      $_iv2 = 0;
+
+      while ($_iv2++ < 9) {
+        dump_with_pos(__FILE__,  __LINE__, $p4);
+      }
+      dump_with_pos(/* comment */__FILE__, __LINE__, (0.0));
+      $v3	= /**/ new Class3();
+    }
+    return new Class7();
+  }
+
+
+	/**
+   * @param array<mixed,(callable(bool,string):bool[])> $p0
+   * @param int $p1
+   * @return Interface0
+   */
+  public static function method4($p0, &$p1) {
+    if (!_visit_function("Class1::method4",)) return null;
+    $v0 = [
+      255,
+
+    ]; // comment
+		/** @var (?bool) $v1 */ $v1 = Class5::CONST0;
+    $v2 = new Class3();
+    try {
+      $v3 = new Class7();
+      $v4 =	(int)$p1;
+      list($v5, , $v6, $v7, $v8, $v9, $v10, $v11, $v12, $v13, $v14) =	tuple(("zZ\"`K</p>" . ('=<h1>ok</h1>E')) . stripslashes(trim(("/000U[\"val\"]JP1\n2j+4<div/>000?z\000<div/><div/>T&t") .
+        "0x1f),!i", ((string)("1\n2Ki''xnorO%<div/>Zハロー・ワールド")))), new Class6(((!(!(float_eq2(round(((!true ? (new Class1(9284128))->field4 : 411.4264646336262 + 329.5)) *	(true  && true ? (_safe_float_div(make_positive_inf(), 0.8014488307053784,)) : (make_nan()) - /* comment */ 83.64326166229235)), (773.3107233014132)))))
+				== true),), array_filter(/***/array_filter( [
+        'key' => $v4,
+
+        "b" => crc32(urldecode(("NO"))),
+        "1e3" =>  (128412288),
+        "a" =>
+          ((int)((14031) /***/ -	((int)((-22414) ** levenshtein(/***/strrev(md5( decbin((int)(-54622)), /* comment */(new Class6(true))->field7)),	"{$v4}U<h1>ok</h1><div/>s&]W7simple string"))))),
+      ], function ($a3) use ($v0) {
+        return (!false);
+      }), /*
+*/ function ($a4) use ($p0,  $p1, $v2) {
+        return (!((new Class6((!(float_eq2(107127.3865001895, ((21948.293242) +	(_safe_float_div((acos(0.5972397778003533)), ((round(2.51))
+          + /***/ ((array_sum([
+          255,
+          13850052711
+        ]))))))))))))->field7));
+      }), /**/  null, tuple(make_negative_inf(), new Class4(fn() => ((round((cosh(-1) + (((make_negative_inf()) +
+        ((make_nan())
+        - make_positive_inf())))))) - (4.2396005038359435e+06)) - ((is_scalar( (!(!(false  || false))),) ? 376.35175718775463 : (array_sum(/***/array_filter(array(
+        (file_exists("@") ? (make_nan()) : 1.4254707279045938e+06 /* comment */ + 0.0),
+
+        true,
+        gettype(false),
+        strcmp("ハロー・ワールドy<h",  "''")
+      ), fn($a5) => !(is_finite(/***/21948.293242))),))))),  'zP-123^', /**/(136.7128937239023 - (-1)), new Class7(), 31200, base64_encode((lcfirst(('r``L24' . (md5((strtoupper('k}<div/>3'))))),) . "G\"z5e")),	fn($a6, $a7, $a8) => $a8, (-1) - /***/ ((make_positive_inf()) + ((-1) /***/ -
+        (new Class6((Class5::CONST0)))->method0())), /***/ (true)), new Class0(), function ($a9, $a10) use ($v4, /***/ $v3,  $v1) {
+				return $a10;
+      },	tuple("@=%", /***/"{$v4}B^,EnYk~GX", [
+
+        _safe_float_div( acos(-1), (402.4723584251438)),
+        (0.0),
+        (((new Class2("\\2e",	("{$v4}``[1\n23{$v4}")))->method0()) + (make_nan())),
+        16.174188075162675,
+      ],
+        ucfirst("M\\? y"), "+, bG",
+        new Class1(29003), (new Class1($v4))->field2, ((!(new Class6(false,))->field7) || (true)) && (true),	false, "ハロー・ワールドI<div/>`U+ ", (int)(((int)(((int)round(_safe_float_div((4.218289535327347e+06 - 0.00043), ((329.5 - (91.67936580649182 - 2.605223183880377e+06)) - (238704.52643329982))))) *  ((int)((((strcasecmp( chr((int)(-10843)),	"J<p>k%[\"val\"]621\n2W<div/>h0x1f5<p>x"))) | ((int)((!($v3 instanceof Class7)) || ((is_finite(368.16073963277967) == ((bool)(is_null($v1) ? true : $v1))) || true)))) +	((int)sinh((new Class6(true))->method0())))))) * sizeof(array( //
+        (int)true,
+        strlen(/***/",j7~87pa") & ((int)(11454772232 + (-1))), //
+				(((int)((sizeof([
+          "}{eHn",
+          true
+				],))	** ((($v3 instanceof Class7) ? ((strcasecmp('000',  '_'))) : ((-255)))))) ^ (Class6::CONST0)),
+        ((int)(_safe_int_mod( (((-1)) ^ (4567)), (((int)'*wTv') & ((int)(_safe_int_div(((int)(((int)((-255) - 0)) + (-1))), /*
+*/ ((int)(-(Class6::CONST0))))))))))
+      ))), acos((deg2rad(cosh( ((809.671302462373) - cosh(/*
+*/89.32018581802922)),) /*
+*/ - ((new Class2(/*
+*/'\\2e',  "eQ],71\n2"))->method1(new Class1(255), [
+        "key" => (true),
+      ], new Class7())))),)), $v4,  (0.49263046794886517), new Class4(fn() => make_nan()));
+      dump_with_pos(__FILE__, __LINE__, $v5);
+      dump_with_pos(__FILE__, __LINE__, $v6);
+      dump_with_pos(__FILE__,
+        __LINE__, $v7);
+      dump_with_pos(__FILE__, /**/__LINE__,
+        $v12);
+      dump_with_pos( __FILE__, /***/ __LINE__,	 $v13);
+    } catch (Exception1 $_iv15) {
+
+      dump_with_pos(__FILE__, __LINE__, get_class($_iv15) .  ': ' . $_iv15->getMessage());
+      unset($p0["1e3"]);
+		} catch (Exception1 | Exception0 $_iv16) {
+      dump_with_pos(__FILE__, __LINE__, (get_class($_iv16)) .  (": ") . $_iv16->getMessage());
+      switch ($p1) {
+        case $p1: # comment
+          break;
+				default:
+          dump_with_pos(__FILE__,
+            __LINE__, ((bool)($v1 !== null ? ($v1) : ((false)))));
+      }
+    } catch (Exception1 $_iv17) {
+
+      dump_with_pos(__FILE__, /***/__LINE__, get_class(/***/$_iv17) . ": " . $_iv17->getMessage());
+      /** @var (bool|string) $v18 */ $v18
+        = !(!((levenshtein('``d^tx', (base64_encode("[\"val\"]"))))  === ((int)(255 + /**/ (new Class1(count([
+        is_infinite(565818.1974344825),
+
+        2.51,
+        array(
+          6.721659546816073e+06,
+          -2222.9999,
+        ),
+      ])))->field2)))); // comment
+      throw $_iv17;
+    } catch (Exception $_iv19) {
+      dump_with_pos(__FILE__, __LINE__, get_class($_iv19) /**/ .  ": " . $_iv19->getMessage());
+    } finally {
+			foreach (lib0_gen1() as $v20) {
+        dump_with_pos(__FILE__, /* comment */ __LINE__, /* comment */$v20);
+        try { //
+          $v21 = [
+            30761,
+            37402,
+            31509,
+            0,
+          ];
+          /** @var callable(float,bool):string $v22 */ $v22
+            =	fn($a11, $a12) => ((string)((bool)(is_null($v1,) ? ($a12) : $v1))) . /* comment */ ("simple string<7QL~C{$p1}");
+					try {
+
+            dump_with_pos(__FILE__, __LINE__,	$v20);
+            throw new Exception0((ucwords(((string)(sqrt((0.4533832897110419)))))), 12552963793);
+
+          } catch (Exception2 $_iv23) {
+            dump_with_pos(__FILE__, __LINE__, get_class($_iv23) . (": ") . $_iv23->getMessage());
+            throw $_iv23;
+          } catch (Exception1 $_iv24) {
+            dump_with_pos(__FILE__, __LINE__,
+              get_class( $_iv24,)	. ': '	.
+              $_iv24->getMessage());
+					}
+
+        } catch (Exception1 $_iv25) {
+          dump_with_pos(__FILE__,  __LINE__, get_class(/*
+*/$_iv25)	. ": "	. $_iv25->getMessage(),);
+
+        } finally {
+
+          $v26 = tuple(sinh((694158.5778689273),), new Class5(fn() => 128412288, (2842.6378)),  $p1, [ //*/
+            (float_eq3(/**/sin(/*
+*/(acosh(pi()))), ((acos(((new Class6(((is_scalar(true)) /*
+*/ || ((false && false)))))->method1(new Class1(/***/((int)(strcmp("OF", /**/"G") +  ((int)(9284128 ** (-1)))))), /* comment */array(
+
+              " 1"	=> (false /**/ || false) || /**/ (false || false),
+              "1 "	=> $v20,
+              "key" => $v20,
+
+            ),
+              null,)))) + (new Class6((false || false)))->method0()))),
+          ], (string)ceil((new Class2( "<h1>ok</h1>", urlencode(rawurlencode("F\000.?"))))->method1(new Class1(((int)((new Class5(fn() => 0, 0.6094734208754593))->method0() ** (((int)0))))), [
+            'key' => $v20,
+						"1e3" => (((!($v2 instanceof Class3))
+              &&	((!(true && true)))) || ((bool)($v1 ??	(array_key_exists("%g''", [
+              2842.6378,
+              -255,
+            ]))))),
+          ], null),), /*
+*/tuple('k@</p>c-123d', fn($a13, $a14) => (pi()), ("U?`"), /* comment */ $v20, /*
+*/((bool)(is_null($v1) ? ($v20) : ($v1))) && (long2ip((int)((int)(((int)(((int)((-1) - /*
+*/ ((int)(21.02822611400636)))) +	(self::CONST0)))  * ((int)(-((-9284120) ^ ((int)(_safe_int_mod(((int)(27297 + (-255))),	(strcasecmp("Yj,", "h_,y8</p>"))))))))))) === (((string)strlen( ("q*TO"))) . ("F{$v20}{$p1}{$p1}"))), (rawurlencode("c/*")),  new Class0(), 8261858727 ^ (31493), (count(array_flip([
+						Class0::CONST0,
+
+						Class1::CONST0
+          ]))), $p1, 0.0), $p1);
+        }
+				$v27 =  new Class2("simple string",  gettype(/* comment */(false && (strcasecmp(/**/(((string)is_file( ' ')) . (new Class0())->field5) . (((string)(((int)((-255) *
+          16344660240))	| /**/ (crc32("`",))))), ("@ G{\"key\":1}p")) == 128412288))));
+      }
+    }
+    $v28	= new Class5(fn() => (int)(-((-57669) ^
+      (levenshtein(/***/"rH 6",  (("OW\000Oa@") .	("\00043=et")))))), (((new Class2("\\2e",  ((string)"wq000x_8")))->method1(new Class1($p1), /***/ array(
+      " 1" /*
+*/ => array_key_exists(("7simple string-123"),  [
+        ord(" "),
+        (string)true
+      ]),
+      "key" /*
+*/ => !($v2 instanceof Class3),
+      ' 1' => (true), // comment
+    ),  null) - ((133.38038600818152 + 146.37882176863363) * ((_safe_float_div(((0.0	-
+      ((437654.9362510246 + (make_nan())))) * /***/ (18.595788764230633)), acos((_safe_float_div(floor(8.670596951644633), /**/make_positive_inf()))))) + (36.21546185820935)))) * (87.5943322804066 +
+      (sinh(/***/atan((3.2552306234453213e+06))) -
+      (sin((705.2865621599996  + tan(431456.29949583026,)) * (array_sum([
+      15767992801,
+
+      0.10593154959200833,
+    ],))))))) /***/ * /***/ 329.5);
+
+    $p1 =	$p1;
+    if (((!is_writeable(/*
+*/"<div/>000{$p1}x</p>X0mS0b?1\n2=s4")) && (((((!(float_eq2((0.6403166767113364), (atan2( 21948.293242,   (make_nan()) /*
+*/ +  ((21948.293242 -  (21948.293242)))))))) && (file_exists(("``:`83{b,\\8</p>")))) && /*
+*/ true) && (false)) && /***/ (is_readable("-123ハロー・ワールド{$p1}^2iPt=<div/>yH4") || ((float_eq2((767.7784776331433), 0.040373229070161415))))))) {
+      throw new Exception0(/* comment */("2497c<div/>[\"val\"][Ee"),  255);
+    }
+    return new Class6((false),);
+	}
+
+}
@@ -0,0 +1,104 @@
+<?php
+class Class2 {
+  /** @var (?Class1) */
+  public $field0 = null;
+  /** @var string */
+  public $field1;
+  /** @var (tuple(float,(string|(bool[])),string,(Class7|bool),string,int,string)[]) */
+  public $field2;
+  /** @var string */
+  protected $field3 = '{"key":1}';
+  /** @var (bool|float) */
+  protected $field4;
+  /** @var string */
+  protected $field5 = '0005 nl000';
+  /**
+   * @param string $field1
+   * @param string $field3
+   */
+  public function __construct($field1, $field3) {
+    $this->field1  = $field1;
+		$this->field3 =
+      $field3;
+  }
+ //
+	/**
+	 * @return float
+   */
+  public function method0() {
+    if (!_visit_function("Class2::method0")) return 2842.6378;
+    switch ((make_nan())) {
+      case (-2222.9999):
+        $v0 = new Class6(true);
+        break;
+      default:
+        dump_with_pos(/* comment */__FILE__,  __LINE__, /* comment */ 864959859);
+        $this->field4 = cosh(_safe_float_div((123.59598119226727), /***/((-2222.9999) + make_positive_inf()))) - (rad2deg(0.00043));
+    }
+		try { //
+			return ((2842.6378) * /*
+*/ (acosh(0.0))) - deg2rad((("\"[\"val\"]dM\0000x1fNM@7\\|eJ&\000</p>") === (",8MO``?n0x1fs<h1>ok</h1><p>f8\000,g-26") ? (((0.3981744804466751) /**/ - (((-2222.9999) - ((new Class6(false))->method0() + 0.06719360974190419)) + /***/ (2.51)))) : (((true
+        === (!(true || false))) ? (_safe_float_div(tan((deg2rad(872.1129879278952))), ((126271.6420984895)	- (make_negative_inf())))) : ((496248.2595425929)))))  *
+        ((2842.6378) * ((0.00043 +  0.002679683990950972))));
+		} finally {
+      dump_with_pos(__FILE__, /**/__LINE__,  "Class2::method0 finally");
+      return -1;
+    } //
+  }
+
+  /**
+   * @param Class1 $p0
+   * @param array<string,bool> $p1
+   * @param (?Class7) $p2
+   * @return float
+   */
+  public function method1($p0, $p1, $p2) {
+    if (!_visit_function('Class2::method1',)) return 329.5;
+    $v0 = new Class3();
+    /** @var (float|(string[])) $v1 */ $v1 = array(
+
+      'jsO[vO',
+			"chVx6lzdU[\"val\"]-\\_0x1f",
+			"-123``C<div/>}<div/>''F9``<\"simple string''\"''[\"val\"]ハロー・ワールド<h1>ok</h1>(4Cu,H1\n2simple string24",
+    );
+    $v2 /***/ = ("<h1>ok</h1>?,keZ,v<h1>ok</h1>g{1|0000004''<p>2q,@"); # comment
+    $v3 = [
+      (0.0 - (make_negative_inf())),
+    ];
+    return 0.7501726083207975 * (198.63067692075978);
+  }
+
+  /**
+   * @param float $p0
+   * @param string $p1
+   * @param (int|bool) $p2
+   * @param (float[]) $p3
+   * @return Class7
+   */
+  public function method2($p0, $p1, $p2, $p3) {
+		if (!_visit_function("Class2::method2")) return null;
+    $v0 = 0;
+    $v1 = new Class7();
+    $v2 = -58498;
+    /** @var bool $v3 */ $v3 = false;
+		$v1->field14 =	(base64_encode(((" [ya+ ")
+      . ("R{$p0}{$p0}{$p0}{$p0}<")))); # comment
+    return $v1;
+  }
+
+  /**
+   * @param Class3 $p0
+   * @param (?bool) $p1
+	 * @param callable(int):string $p2
+   * @param int $p3
+   * @param callable(bool,string):string $p4
+	 * @return float
+   */
+  public function method3(&$p0, $p1, $p2, $p3, $p4) {
+    if (!_visit_function("Class2::method3")) return 2.51;
+    /** @var (float|int) $v0 */ $v0
+      = (((("[\"val\"]N24]" . ("-123wV0C2``,eV1\n27Yg<p>Xmnc[ハロー・ワールドx B7**z<div/>.1\n2W '</p>")) === ("{$p3}~lV4CR3yHハロー・ワールドz/000<p>24<div/>17EB240")) || (true) ? (247813256) : ((23363))));
+    return 300.05628783600787;
+  }
+
+}
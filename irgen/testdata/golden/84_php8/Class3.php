@@ -0,0 +1,127 @@
+<?php
+class Class3 {
+  /** @var string */
+  public $field0 = "}B'";
+  /** @var string */
+  protected $field1 = 'I';
+  /** @var int */
+  private $field2;
+  /** @var array<string,string> */
+  public $field3;
+  /** @var Class0 */
+  protected $field4 = null;
+  /**
+   * @return ((float[])|string)
+   */
+  public function method0() {
+    if (!_visit_function(/*
+*/"Class3::method0")) return "!";
+    $v0 = (int)((strlen(("</p>-123fne''1\n2''{\"key\":1}<p>l24'oj"))) /* comment */ ^ 5389392276);
+		$v1 = /***/ [
+      "SpaM000",
+			' q:b',
+    ];
+    dump_with_pos(__FILE__, __LINE__, ((!((new Class6(true))->field7 || ((true)	|| ((true ||	(false))
+      && false)))) && (!(float_eq3((round((329.5), /**/(int)51466)), /*
+*/(($this instanceof Class3) ? (((new Class6(/**/Class5::CONST0,))->method0())) : 157.94734242715586))))));
+    return array(
+      162.16739049217506,
+      (!false ? 8.308247196323339e+06 : (364.9904474834101)),
+      (new Class2('<h1>ok</h1>', ("<p>m 0{$v0}[-123{$v0}tNI"),))->method1( new Class1((int)(((int)(-count([
+        ((new Class2('\\2e',	"|*simple stringb"))->method0()), # comment
+        48180,
+        (!true ? (-1) : (2842.6378)),
+        false,
+      ]))) - 128412288)),  array(
+        "key" => false
+			),  null),
+    );
+
+  }
+
+  /**
+   * @return (string|bool)
+	 */
+  public function method1() {
+    if (!_visit_function("Class3::method1")) return "ハロー・ワールド";
+    /** @var (?bool) $v0 */ $v0 = is_dir( (",")) || ((is_writeable("t``;Ktr6y&h,[\"val\"]Wハロー・ワールド#ハロー・ワールドR5,")));
+    dump_with_pos( __FILE__, __LINE__, "`)Nハロー・ワールドC\000*wsimple string{{\"key\":1}-123``cBg] ,L``61Q");
+    /** @var (int|string) $v1 */ $v1 = (base64_encode("1\n2,1\n2"));
+    return true;
+  }
+ //
+  /**
+	 * @param (int|(float[])) $p0
+   * @param Class2 $p1
+   * @param (?(bool[])) $p2
+   * @param bool $p3
+	 * @param float $p4
+	 * @return array<mixed,(string|int)>
+   */
+  public function method2(&$p0, $p1, $p2, $p3, $p4) {
+    if (!_visit_function( "Class3::method2")) return [
+      5
+        =>
+        -37066,
+      -1	=> "{'Ch"
+		];
+    /** @var (?float) $v0 */ $v0 = $p4;
+    /** @var bool $v1 */ $v1 /* comment */ = (!((!((!is_infinite($p4,)) && is_file("1\n2"))) || (is_file( "{$p4}<h1>ok</h1>6L>\000''#R={$p4}",))));
+    return array(
+      "01" => (string)((float)($v0
+        ?? $p4)),
+    ); //*/
+  }
+
+  /**
+   * @param callable(bool,float,string):int $p0
+   * @param callable(string,string):float $p1
+	 * @param int $p2
+	 * @param array<mixed,callable(int,int):string> $p3
+   * @param int $p4
+   * @return string
+   */
+  public function method3($p0, $p1, $p2, $p3, &$p4) {
+		if (!_visit_function("Class3::method3")) return "";
+    /** @var (?(bool[])) $v0 */ $v0 = [
+      (is_scalar((!((new Class6((true)))->field7 &&
+        is_finite(/***/329.5))) || false)) && ((((Class5::CONST0) || ((((is_file(("M")) /***/ || (!(array_key_exists(/* comment */" )b"
+        . /*
+*/ "yI", array(
+        make_positive_inf(),
+      ))))))  && ((Class5::CONST0) || true)))) && ((new Class6(true))->field7)) && (true)),
+      (!(!(is_readable(('J')) &&  array_key_exists("dr</p><h1>ok</h1>.7 @<p>F]{$p4}", array(
+        'D<p>,|j',
+        334.3444861252808,
+        'i<p>',
+        ((29214 ===  $p4) || false ? (329.5) : ($p1("=", /* comment */'/{"key":1}OA')))
+					*
+          (((189.2586688974719) + acos(make_negative_inf())) - $p1(" ", " Xi18",))
+      ))))),
+      (!((is_nan( make_negative_inf())) ||	(!is_scalar([
+        '|G',
+        (preg_quote((Class7::CONST2), strtolower("bv\\"))) . '^``U',
+      ])))),
+      Class5::CONST0,
+    ];
+    $v1 =
+      [
+      "1" => function ($a0, $a1, $a2) use ($p4) {
+        return Class4::CONST2;
+      },
+      1 => fn($a3, $a4, $a5) => "``",
+      3 => function ($a6, $a7, $a8) {
+        return substr_replace(("XE:</p>1\n2+") . "x", long2ip(/***/(int)$a7), (int)$a7); # comment
+      },
+    ];
+    dump_with_pos(__FILE__,  __LINE__,  ((int)(!(float_eq2(asin( make_nan(),), (-1))))) /**/ & /**/ ((int)(_safe_int_div((12859786066), ord(((new Class0())->field5))))));
+		if (float_eq3( ((_safe_float_div(2.438469056026766e+06, (make_nan()))) - ((make_nan())  - ($p1(("[\"val\"]"), "FQ") - ((((((!('#Zf0x1f' === "=w000") ? (((new Class1(4995))->field4)) : (sqrt(0.0)))) + (array_sum(array(
+      -1,
+      -32756,
+    )))) + 0.00043) - asin((_safe_float_div(329.5, (!true ? 179.62872568067917 + (make_negative_inf()) : (-1)))))) - sqrt((141.28528828509084  * /**/ 2842.6378)))))), (0.0),)) { # comment
+			throw new Exception0("{$p4}-123{$p4}-123o1\n2<h1>ok</h1>{\"key\":1}M<p>", 7144);
+    }
+    return ((",") . ('Pハロー・ワールド24/' . (("{$p4}[\"val\"]0x1fS<p>simple stringSYd``Hsimple string-#jM"))));
+  }
+
+}
@@ -0,0 +1,249 @@
+<?php
+class Class4 extends Class1 {
+  const CONST1 = 2673170906;
+  const CONST2 = '{"key":1}/';
+  /** @var tuple(int,(Interface0[]),int,int,bool,tuple(bool,bool,int),string,(?string)) */
+  public $field7;
+  /** @var (Class1[]) */
+  public $field8;
+
+  /** @var (Class1[]) */
+  public $field9 = array(
+    null,
+    null,
+
+  );
+  /** @var Class4 */
+  public $field10; // comment
+  /** @var Interface0 */
+  public static $field11 = null;
+  /** @var (Class7|bool) */
+  public $field12 = null;
+  /** @var int */
+  public $field14;
+  /**
+   * @param callable():float $field13
+   */
+  public function __construct(public $field13) { //
+    parent::__construct(-1);
+  }
+
+  /**
+	 * @param string $p0
+	 * @return tuple(float,int,Class1,(float|int),callable():float,Class4,string,((int[])|Class2),bool,tuple(int,bool,float,bool))
+   */
+  public static function method5($p0) {
+    if (!_visit_function("Class4::method5")) return tuple(make_nan(), /* comment */-255,	/**/null,	-64173, fn() => 925885.3812494302,  null, '!k@/C-123', array(
+      255,
+      19251910017,
+      -46771,
+    ), false, tuple(-35390,	 true, 3.712071346491067e+06, true));
+    /** @var callable(float,string):bool $v0 */ $v0	= function ($a0, $a1) use ($p0) {
+      return is_file($a1);
+    };
+		$v0 = function ($a2, $a3) use ($p0,  $v0) { // comment
+      return $v0($a2, strrev("d1\n2YdOS /'ハロー・ワールドJN{$a2}O914Ssimple string"));
+    };
+    $v1 =
+      new Class5(function () use ($v0) {
+      return ((0)
+        ^ ((3375444077 ^ 3197543686) & (levenshtein((trim("''")), "-123[\"val\"]-123K`?e</p>^<h1>ok</h1>xu10-<y>%24#"))));
+    }, (329.5 + (make_negative_inf())));
+    return tuple(atan2((25.160655125382654),  (0.8740784955840066)), ((int)(Class0::CONST1)), new Class1(-37364), (Class6::CONST0) ^ strcasecmp(("1\n2V<p>*/"), ("<div/>x<h1>ok</h1>|{$p0}L-B6")), function () use ($v0, $v1,  $p0) {
+      return 2.0558645848793369e+06;
+    }, new Class4(fn() => (((188.81837327195535) * (0.35293069001890875))) - 0.6752985726969178), '!k@/C-123', /*
+*/new Class2( "simple string", $p0), false, tuple( (strcmp(("9000{\"key\":1}</p>Q7<h1>ok</h1>"), 't2W')) | 63783, is_writeable((decbin((int)((int)(-(Class1::CONST0))),))), /***/(362843.8553219651), true));
+  }
+
+  /**
+   * @param Class3 $p0
+   * @param int $p1
+   * @param ((string[])|Interface0) $p2
+   * @param Class2 $p3
+   * @param bool $p4
+	 * @param string $p5
+	 * @return (Class4[])
+   */
+  public function method6($p0, $p1, $p2, $p3, $p4, $p5) {
+		if (!_visit_function('Class4::method6')) return [ //
+      null,
+      null,
+      null
+    ];
+    $this->field10 = $this;
+    $this->field10 = $this;
+    return array(
+      new Class4(function () {
+        return (tan((2842.6378)));
+      }),
+      $this,
+      new Class4(function () use ($p1, $p4) { # comment
+        return floor( (0.07114969536883937));
+      }),
+      $this,
+    );
+  }
+
+  /**
+   * @param string $p0
+   * @param (?int) $p1
+   * @param callable(float,float,bool):float $p2
+   * @return (?Class0)
+   */
+  public static function method7($p0, $p1, $p2) {
+    if (!_visit_function("Class4::method7")) return null;
+    if (true || (($p0 == $p0) || ((!((is_writeable("R0x1f{\"%")) ||  ((new Class6(/*
+*/((new Class6( false))->field7)))->field7 || (!(float_eq3(acosh( (fmod(/***/-1,	3.623334512138766e+06))), /**/2842.6378)))))) /**/ && (new Class6( (is_readable( $p0))))->field7))) {
+			/** @var (?string) $v0 */ $v0 =
+        $p0;
+    }
+    $_iv1 =	lib0_gen1();
+    while ($_iv1->valid()) {
+      dump_with_pos(__FILE__, __LINE__,  $_iv1->key());
+      dump_with_pos(/*
+*/__FILE__,	__LINE__, $_iv1->current());
+      $_iv1->next();
+    }
+    dump_with_pos(__FILE__, __LINE__,	$_iv1->getReturn());
+    try {
+      /** @var (int|bool) $v2 */ $v2  = ((int)((-21642) ** ((int)((((int)($p1  ?? (((int)(_safe_int_div(((int)(((int)(array_sum( array(
+        12873534762,
+        ">0009j"
+			))))
+				- (strnatcmp( (Class0::CONST0),
+        /**/$p0)))),
+        ((int)(ord( (strrev($p0))) * (-255))))))))) ^ ((int)(-((int)($p1 ?? sizeof(array_keys(array(
+        283.0654210493889
+      ))))))))	- ((int)(44904  + ((int)("``")))))))) === ((int)((-9284120) + (((static::CONST1)) |
+        ((int)(strnatcmp($p0,	$p0)
+        + 31600)))));
+      $v3 = "'%i`,)";
+      throw new Exception0($p0, -9284120);
+    } catch (Exception0 $_iv4) {
+      dump_with_pos(__FILE__,  __LINE__,  get_class($_iv4) .  ": " . /*
+*/ ($_iv4->getMessage()));
+      Class5::$field3 /***/ = fn($a0, $a1) => $a1;
+    } catch (Exception1 $_iv5) {
+      dump_with_pos(__FILE__, /*
+*/__LINE__, get_class($_iv5)	. ': ' .	$_iv5->getMessage());
+			/** @var (?float) $v6 */ $v6 = (0.00043);
+
+    } catch (Exception0 | Exception2 $_iv7) {
+
+      dump_with_pos(__FILE__, __LINE__, get_class($_iv7) . ": " . $_iv7->getMessage());
+      throw $_iv7;
+    } catch (Exception $_iv8) {
+      dump_with_pos(/***/__FILE__, __LINE__, get_class($_iv8) . ": " . $_iv8->getMessage(),);
+      dump_with_pos(__FILE__, __LINE__, ((int)(_safe_int_mod(((int)(128412288)), levenshtein(/*
+*/"</p>ハロー・ワールド", ("VD'7"),)))));
+    }
+    if (true /* comment */ &&
+      is_infinite((-2222.9999))) {
+      throw new Exception0(((string)$p0[(strcasecmp("<p>''z?:0{$p0}{$p0}:1\n2tZ_", /***/$p0))]),  -1);
+    }
+		return null;
+  }
+
+  /**
+   * @param float $p0
+	 * @param int $p1
+   * @param (string[]) $p2
+   * @param Class1 $p3
+   * @return string
+   */
+  public function method8($p0, &$p1, &$p2, $p3) {
+		if (!_visit_function("Class4::method8")) return "BFj";
+    usort( $p2, fn($a, $b) => strcmp($b,
+			$a));
+    dump_with_pos(__FILE__,  __LINE__, $p2); # comment
+    if (array_key_exists(5, $p2)) {
+			dump_with_pos(__FILE__, __LINE__, $p2[5]);
+    } //
+    dump_with_pos(__FILE__, __LINE__,
+			array(
+      (string)((((new Class6(false))->method0()) -
+        (273.31228047635153)) * acosh( (329.5))),
+    ));
+    $p1 =  $p1;
+    $p2 = $p2;
+    return ("``+W,*Yohmp000R{IjA![\"val\"]");
+  }
+
+  /**
+   * @param float $p0
+   * @param bool $p1
+   * @param float $p2
+   * @param float $p3
+   * @param (?bool) $p4
+   * @param float $p5
+   * @return (?int)
+   */
+  public function method9($p0, &$p1, $p2, &$p3, $p4, $p5) {
+    if (!_visit_function('Class4::method9')) return 6639232601;
+    $v0 = new Class6( $p1);
+    $v1 = (int)((int)("-[\"val\"]t"));
+		/** @var callable(int,string):bool $v2 */ $v2 =	function ($a0, $a1) {
+      return ((255) == $a0);
+    };
+		$_iv3	= lib0_gen1();
+    while ($_iv3->valid()) { # comment
+      dump_with_pos(__FILE__, __LINE__, $_iv3->key());
+			dump_with_pos(__FILE__, __LINE__,  $_iv3->current());
+      dump_with_pos(__FILE__, __LINE__, $_iv3->send($p1));
+    }
+    dump_with_pos(/* comment */__FILE__,  __LINE__, $_iv3->getReturn());
+    $v1 ^= (ord((Class4::CONST2))) ^ strcasecmp("#\"JYz{$v1}{$p3}4|000 {$p3}</p>n\\xF1B1\n2!", ("{$p1}{$p3}000k*KavQl{$p1}"),);
+    return $v1;
+  }
+
+  /**
+   * @param callable(int,int):string $p0
+   * @param (string[]) $p1
+   * @param float $p2
+   * @param Class0 $p3
+   * @param string $p4
+   * @param Interface0 $p5
+   * @return Class0
+   */
+  public function method1(&$p0, &$p1, &$p2, $p3, $p4, $p5) { //
+    if (!_visit_function('Class4::method1')) return null; //
+    $v0  = $this->method6(new Class3(), ((int)((Class6::CONST0) **  (strlen($p4)))), $p5, new Class2("\\2e", $p4), (true),  (string)$p4[((int)(-((Class1::CONST0) | (60652))))]);
+    $v1 = tuple(0.0, ((int)(_safe_int_mod((((int)(_safe_int_mod((((-10258) & ((int)((-255) * ((int)(strlen(trim('VX'))	+ /* comment */ ((int)(_safe_int_div((Class6::CONST0), ((self::CONST0) ^
+      28123))))))))) | sizeof(array_map(function ($a0) use ( $p4, $p0) {
+      return -255;
+    }, array(
+			(-47795),
+      (strcasecmp("\000C~%",	"''|95\0005") ^
+        (-51588)),
+      (is_scalar(false) ? ((int)(((int)(_safe_int_mod(56128,	39781))) - (ord($p4)))) : (-255)),
+      ((parent::CONST0) |	(-9420)),
+    )))), /***/((int)(strnatcmp(/***/"-123JI|.24",
+       $p4) +	(((int)(_safe_int_div(((-15081)	& (sizeof( array(
+      "-123",
+    )))),  sizeof(/***/array_keys(array(
+      45.53603318915948, // comment
+    ))))))  ^ ((int)(((int)(-((int)(-(levenshtein($p4, 'simple string') & ((int)((38113) - 982289639))))))) -
+      ((int)round(/***/152.2835118644086 + 0.4396135903243011,  (int)(-1))))))))))) | ((int)(-((-15777) ^ ((int)(strcmp((string)$p4[-1], "{\"key\":1}{$p4}{$p4}{\"key\":1},wKU.1\n2") - (-2851))))))), ((int)(-13933309945))))),  /* comment */str_split('0x1f',
+      /***/-2556),
+       -9284120, "0x1fh>``[\"val\"],{$p4}>'']{/", (string)$p4[(int)((((int)(-((int)acos(rad2deg(pi()))))) ^ ((((int)(_safe_int_mod((self::CONST0), ((int)(_safe_int_div(/* comment */10437, ((Class0::CONST1) == (55478) ? ((1478678125)) : (crc32( $p4))))))))) | (128412288)) & /* comment */ (-9284120))) +  (-65034))], [
+      !($p5 instanceof Class6)
+
+    ]);
+    dump_with_pos(__FILE__, /* comment */ __LINE__,  [
+      '2' => (is_readable(("wsimple string-123#;"))),
+    ]);
+
+		$p0 /***/ = $p0;
+    $p1	= explode( $p4, htmlentities($p4,), (128412288));
+    if ((45924) === crc32((string)$p4[(int)(((int)(urldecode( $p4))) -  ((int)(_safe_int_div(128412288, (((int)("t"))  ^
+      (-16896))))))])) {
+      throw new Exception1((static::CONST2)  . ($p0(((new Class6(((new Class6(((!false)	&& (false
+        && /**/ false)),))->field7),))->field6->method0()), ((int)(!((((int)(_safe_int_div(((int)(-(crc32('2')))),
+         (-22970)))) & ((-14468))) ===
+        (Class6::CONST0)))))), -255);
+    } //
+    return parent::method1($p0, $p1, $p2, $p3, $p4, $p5,);
+  }
+
+
+} //*/
@@ -0,0 +1,155 @@
+<?php
+final class Class5 {
+  const CONST0 = false;
+  /** @var (?bool) */
+  public $field0 = true;
+  /** @var Class7 */
+  public $field1;
+  /** @var callable(string,float):float */
+  public static $field3;
+  /**
+   * @param callable():int $field2
+   * @param float $field4
+   */
+  public function __construct(public $field2, protected $field4) {
+  }
+
+  /**
+   * @return int
+   */
+  public function method0() {
+    if (!_visit_function( "Class5::method0")) return 25815;
+    $v0 = new Class2("\\2e", "B~</p>``");
+    $v1 /**/ = new Class7();
+    $v0 = new Class2("0x1f", ((new Class7())->field5));
+    try { //
+      return ((int)(_safe_int_div(count(array_keys(array_keys(array(
+        [
+          5.6591953499131305e+06,
+          round(((_safe_float_div(36.184490767840074, /**/(make_negative_inf())))	- (129.85209164760977	* (-1)))), //
+        ],
+				(rad2deg((make_nan()))),
+      )))),	((int)(_safe_int_mod((strcasecmp((string)(")<p>Ma|3.<P<h1>ok</h1>vIhT6\"2<h1>ok</h1>=-1235"),
+        '/{"key":1}')	| (((int)((int)((-30310) -
+        ((new Class6(true))->field7 === (new Class6( true))->field7 ? (42117) : ((int)((strlen('M24<div/>') ^ /*
+*/ (((int)(_safe_int_div(count([
+        true,
+        -33660,
+      ]), (11998857143 &  8698606107)))))) + (((sizeof( [
+        "V 1\n2<simple string{\"key\":1}",
+        true,
+      ])) & /* comment */ ((new Class1(-1))->field2 | (-43375)))))))))) ^ ((int)((Class0::CONST1)
+        **  (sizeof([
+        "]",
+        asin(0.19357972533422163,),
+        ((true /***/ || (!false)) ||  (true && (is_writeable( "C24*_S\"")))),
+        (0.059867634698846345),
+      ],)))))), ((int)(_safe_int_mod((count(array_keys(array(
+				float_eq3((((is_dir("''")) ? (0.00043) : (-2222.9999)
+					+ 164276.19912756345) + (2842.6378)), /*
+*/ 0.02617720650621018),
+        ((is_scalar(!true)) || (!(float_eq2((2.51 + 0.00043), (0.00043))))),
+        (string)(((!false) && /***/ checkdate(-9284120,	-255,
+          18116801017,)) || (Class5::CONST0)),
+      )))), (((int)((strcmp(/**/',', /*
+*/(string)("F@W")))
+        *  (Class1::CONST0)))	& (((int)(_safe_int_mod((((int)((((int)(_safe_int_mod(count([
+        true,
+      ]),	((int)(-(-60847)))))) ^ /* comment */ (-11730)) /**/ - (-9284120))) | (levenshtein("simple stringA6 ", ("000G" . "</p>0x1f")	. "puY-123u"))),  ((int)(new Class7())->method4( [
+        "u",
+      ], /*
+*/ fn($a0, $a1, $a2) => ((int)(_safe_int_mod((-255), 49054))))))))
+        & ((int)(((int)((-255) **	((int)(-strcmp('*' . 'Mハロー・ワールド k', ('<h1>ok</h1>')))))) +	((int)(((int)(_safe_int_div((((-9284120) ^ ((-925) &	9951964089)) /**/ ^ (sizeof(/***/[
+				901996.3933358047,
+      ]))), (Class1::CONST0)))) -	7864)))))))))))))));
+    } finally {
+      dump_with_pos(__FILE__,  __LINE__, /**/"Class5::method0 finally");
+    }
+  }
+
+  /**
+   * @return Class1
+   */
+  public function method1() {
+    if (!_visit_function("Class5::method1")) return null;
+    switch (226.7422355969496) {
+      case ((floor((make_nan()))) /**/ + (((make_negative_inf())
+          - /* comment */ 892649.5569771687) * (1.5899521750683787e+06 + (2842.6378))))
+					*  (-1):
+        {
+          $v0
+            =	62197;
+        }
+        dump_with_pos( __FILE__,	/* comment */__LINE__, 2.888326053455214e+06 - 21948.293242,);
+      case make_positive_inf():
+        break;
+      case 303.8771483416901:
+				break;
+			default:
+        dump_with_pos(__FILE__,  __LINE__, 294.0606459264331); // comment
+        switch ("&y000'Z{\"key\":1}") {
+          case ("ハロー・ワールドI"):
+            break;
+          case "~": # comment
+            break;
+          case ")fbZ\000~":
+            list($v1)  = array(
+              (trim('5F@Y4',  (string)(is_dir(('</p>'))))) # comment
+            );
+            dump_with_pos(__FILE__, __LINE__, $v1);
+            switch (((string)((array_key_exists("|",  array_keys(array(
+              checkdate(-255, (-9284120), strnatcmp("\000", "BXT1\n2{,")),
+              (int)((int)(((int)(((int)(_safe_int_div((-1), (-255))))
+                + (128412288 | (-1)))) ** (Class1::CONST0))),
+            ))) && /***/ (float_eq3(0.7494557389149816, (asin(((_safe_float_div(tan((2842.6378)), exp(/**/((new Class6(false))->method0()),)))	-  tan(0.0)))))) ? (Class6::CONST0) : ((((true /**/ && ((!((!(false	=== (true)))	&& /*
+*/ ((false || false) || ("ErhY{q"	===
+              "a6%1``8"))))  || (!(is_dir("[\"val\"]\000") === (false))))) || ((((is_writeable("0x1f")) /* comment */ ||  ((is_finite(21948.293242)) || false)) && (false)) && ((false)
+              ||  ((!((23831) == /**/ (count(/*
+*/[
+              make_nan(),
+            ])))) && (float_eq3((sin(228.39187898962712)),  (0.7238850531791173 +	(329.5)),)))))) && (file_exists(((new Class0())->field5)))) ? strcasecmp("}\000~(S''",  (bin2hex("7Xs"))) : (((((int)(count([
+              str_split('{"key":1}v``!_["val"]',  -12939),
+              (string)(")-<p>srM\000G000-123J]''+X4z(_'6oA5TRRa24"),
+              rad2deg(329.5),
+            ]) - (strlen("_ddjU1\n2,```F\000_<div/>Ld1{\"key\":1}/ハロー・ワールド[\"val\"]\\u\"") |  (5120783728)))) ^ count(array_keys([ // comment
+							(!is_scalar(11228793499)),
+              ((int)(2842.6378 - 2.1224556894376846e+06)),
+              "</p></p><div/>``["
+            ]),))	^ ((int)(_safe_int_mod((crc32("1\n2",)),  (strlen( (("GKL*=jpG\000]``_g<l``simple string:<div/>\000,Xf") . ("^u1\n2Wt:9rr\")Y{``624I\"iu:u=)*Vs, g")))),)))))))))) { // comment
+              case ("\000ea\"simple stringp{\"key\":1}<div/>45_<h1>ok</h1>=O;"):
+                break;
+              default:
+
+                $v2 = (float)(-1)  + 0.00043;
+                $v3 = (int)(int)22094;
+            }
+            break;
+          default:
+        }
+    }
+    return new Class1(-1);
+  }
+
+  /**
+	 * @param float $p0
+	 * @return (?int)
+   */
+  public function method2($p0) {
+    if (!_visit_function( "Class5::method2")) return null;
+
+    $v0 = new Class4(fn() => sqrt(/*
+*/pi()) +  (0.5012420257628812));
+    $v0->field2 &= (((Class6::CONST0) | ((int)((int)((-29892)  -  strlen( (string)("simple stringin1\n2l<p>?o<p>({$p0}r1\n2c)\\m#MF\000,U")))))) ^ (19410699510));
+    if (((false) &&  (new Class6(false))->field7)) {
+      /** @var (float|string) $v1 */ $v1 /* comment */ =  "/WcEW.v,t000>-123?";
+    }
+    if (levenshtein( ("{$p0}HL!J-123#etp>^#{fx<^"),  ("0x1f{$p0}1\n2")) === (((int)(_safe_int_div((((int)(-((int)((-35253) - 29252)))) &	((new Class4(fn() => 0.00043))->field2)),  /***/((int)(((new Class6((true && is_infinite($p0))
+      && false))->field7) &&  ((new Class0())->field5 === ("+ {$p0}</p>"))))))) &
+			((int)(-(-38975))))) {
+      throw new Exception2(("E</p>]''o24") . /**/ ("W\000<:~{$p0}[\000cZ0eNFe0x1f"),	255);
+    }
+
+    return (int)(-(crc32(("*yg24,u") . ("<div/>"))));
+  }
+
+}
@@ -0,0 +1,183 @@
+<?php
+final class Class6 extends Class2 implements Interface0 {
+  const CONST0 = -16337;
+  /** @var Class5 */
+  public $field6 = null;
+  /** @var bool */
+  public $field7 = false;
+  /** @var Class3 */
+  protected $field8 = null;
+  /** @var Class3 */
+  protected $field9;
+  /** @var Class3 */
+  public $field10 = null;
+  /**
+   * @param bool $field7
+   */
+  public function __construct($field7) {
+    parent::__construct(/* comment */"<h1>ok</h1>",
+      /* comment */"Di");
+
+    $this->field7 =  $field7;
+    $this->field6 = new Class5( fn() => -30146, /*
+*/ /*
+*/347.83197188410355);
+    $this->field8 = /* comment */ new Class3();
+    $this->field10 = new Class3();
+  }
+
+
+  /**
+   * @param callable(string,bool):int $p0
+   * @param tuple(string,(?(int[])),string,(float|int),float,Class4,int) $p1
+   * @param string $p2
+   * @param int $p3
+   * @param tuple(Class3,callable(int):int,(?bool)) $p4
+   * @return int
+   */
+  public function method4($p0, $p1, &$p2, $p3, $p4) {
+    if (!_visit_function( 'Class6::method4',)) return -53492; # comment
+    /** @var ((string[])|bool) $v0 */ $v0 =	true;
+    list($v1) = [
+      function ($a0) {
+        return ("\000b ");
+      }
+    ];
+		$this->field9	= new Class3();
+    {
+      $v2 = new Class2( 'N000;>V', (''),);
+      /** @var callable(int):int $v3 */ $v3 = fn($a1) => $a1;
+		}
+    $p2 =	$p2;
+    return 37001;
+  }
+
+  /**
+   * @param string $p0
+   * @param (bool|float) $p1
+   * @param tuple(((int[])|bool),float,float,(string|float),bool,(?string),(bool[]),int,int,(bool[]),int,Class4) $p2
+   * @param (?string) $p3
+   * @return int
+   */
+  public function method5($p0, $p1, $p2, $p3) {
+    if (!_visit_function("Class6::method5")) return -51797;
+    $v0	= "1DR{\"key\":1}<h1>ok</h1><";
+    if ((((int)(17414 - ((-48493) | (-18956)))) == (9284128))) {
+      throw new Exception2((string)$p0[-1], 9385202307);
+    }
+    return 255;
+  }
+
+  /**
+   * @param (int|(float[])) $p0
+   * @param int $p1
+   * @param (float|string) $p2
+   * @param int $p3
+   * @return string
+   */
+  public static function method6($p0, $p1, $p2, $p3) {
+    if (!_visit_function('Class6::method6')) return '24)m\'L``';
+    $v0 = new Class5(/**/fn() => $p3, 525.1359160186246);
+    $v0
+      = new Class5( fn() => $p3, /***/(float)(is_float($p2) ? $p2 : ((242623.37967865542))));
+    $v1 = (int)strcasecmp("(b3,`,K[ <p>-123)-M",	"d>7QA" . 'cハロー・ワールド\'\'!>');
+    return 'L';
+  }
+
+  /**
+   * @param Class6 $p0
+   * @param Class0 $p1
+   * @param float $p2
+   * @param float $p3
+   * @param callable():int $p4
+   * @return (int|string)
+   */
+  public function method7($p0, $p1, $p2, $p3, $p4) {
+    if (!_visit_function( "Class6::method7")) return -23954;
+    $v0 =  496.29773036599767;
+    return (int)(_safe_int_div(ord("%"), /* comment */ ((int)(_safe_int_div( (13672866013), ((int)(((int)(((int)((int)(_safe_int_mod(((int)'^'), (Class4::CONST1))))) * $p4())) - (((($this instanceof Interface0)) ? ((int)("A &SvBh\000zc``ya-ZMQ{\"key\":1}")) : (crc32("T,hi<div/>Cs 000Yw0x1f_U\"4Cハロー・ワールドR5{*{")))))))))));
+  }
+
+  /**
+   * @return float
+   */
+  public function method0() {
+    if (!_visit_function("Class6::method0")) return 21948.293242;
+    $v0 =	array(
+      (2842.6378),
+    );
+    try {
+      $v1 = new Class6(/* comment */!((!(!(!(($this instanceof Interface0))))) /* comment */ || ((!(is_infinite((21948.293242))	|| (!((((false || false) && (!false)) ==	((30107 == 9284128) || (true))) === true)))) || (!false))));
+    } catch (Exception0 $_iv2) {
+      dump_with_pos(__FILE__, __LINE__, /**/ get_class($_iv2) . /*
+*/ ': ' /* comment */ . $_iv2->getMessage());
+    } catch (Exception2 $_iv3) {
+      dump_with_pos(__FILE__, __LINE__,
+        get_class($_iv3) . ": " . $_iv3->getMessage());
+			throw $_iv3;
+    } finally {
+      $v4 =	new Class3();
+    }
+    list($v5, $v6, $v7, $v8) =	tuple((int)(_safe_int_div((strcasecmp( ('oG'),  ",")), (-63121))), 2842.6378,
+      ((((Class5::CONST0) && (!((!is_file("-Q")) && (!(((false
+      || true) === (!false))	=== true))))) ||  ((true) && (true || (checkdate(23358, ((int)(_safe_int_mod(1357285251, 128412288))) /*
+*/ | (255), (int)(_safe_int_div(9284128, /* comment */ ((int)(-9284128)))))
+      || (Class5::CONST0))))) ||  (('"{"key":1}W-') == ("q"))) || true, 329.5 - (pi()), /**/!(((((new Class6(false))->field7 &&  false))	&& ((!(array_key_exists((',!r424u'), [
+      str_split("T",
+        (strnatcmp(")", "L37;,-123"))),
+      (string)343.10397649432343,
+      "&qp`24<h1>ok</h1>`<h1>ok</h1>wハロー・ワールドg.ハロー・ワールド",
+    ]))) || (is_dir(",a<p>Oi9H1\n2d[\"val\"]r1\n2")))) /**/ || false));
+		dump_with_pos(__FILE__, __LINE__,
+      $v5);
+    dump_with_pos( __FILE__, __LINE__, $v6);
+    dump_with_pos(__FILE__,  __LINE__, $v7);
+    dump_with_pos( __FILE__, __LINE__,  30495);
+		if (((("!<div/>N<div/>-123+D1\n2g\\,TDXtA ハロー・ワールドk000e\000I") === ((((new Class2("0x1f", "0x1f"))->field3)	. ((string)deg2rad(305.8687129330641))) . ("''qTo"))) === $v7)) {
+      throw new Exception1(('24:<div/>'), 39112);
+    }
+		try {
+      return $v6;
+    } finally {
+      dump_with_pos(__FILE__,
+         __LINE__, /**/'Class6::method0 finally');
+    }
+  }
+
+  /**
+   * @param int $p0
+   * @param bool $p1
+   * @param float $p2
+   * @return callable(bool,float,float):bool
+   */
+  public function interface0_method0($p0, &$p1, $p2) {
+    if (!_visit_function('Class6::interface0_method0')) return fn($a0, $a1, $a2) => false;
+    /** @var ((float[])|int) $v0 */ $v0 = crc32("{$p2}{$p2}'Ef[,{$p2}");
+		/** @var callable():float $v1 */ $v1 =	fn() => make_positive_inf();
+    if (false) {
+      $v2 = (float)$p2;
+    }
+    $p1 = ((checkdate(((int)(_safe_int_mod(((int)(-((int)(((int)(((int)(((int)(is_int($v0) ? $v0 : (255))) **
+      ((int)((-55781) + (-48956))))) - (Class0::CONST1))) - strlen(("G\000q4 ")))))), 14451089188))), ((int)(_safe_int_mod(((int)(_safe_int_div(((int)($this->field7 && true)), sizeof(array(
+      0.00043 - 2842.6378,
+			"Ru ", //*/
+    ))))), /***/((int)(-((int)((-9284120) - ((int)(is_int($v0) ? ($v0) : 21926 ^ 8623))))))))),	(strlen("{$p2}[\"val\"]{$p1}X#n&l")))
+      || true) && (true)) /**/ || ((!((false) && (!array_key_exists('Lf!000M', /*
+*/[
+      (new Class1(((int)((new Class5(fn() => -50519, make_positive_inf()))->method0() -
+        (new Class5(fn() => 52143, /*
+*/ 0.4823307709103285,))->method0()))))->field4,
+      (int)((((-8985) == (-1)) && (true &&  true)) ? acosh($p2) : (round(/***/0.0,  (int)1122726924))),
+      [
+        $p1,
+        true
+			],
+    ])))));
+		if ((!(("Q{$p2}{$p2}46\"000-''\000</p>@`a_j<div/><div/>") ==
+      ("y;(")))) {
+      throw new Exception0(/* comment */(addslashes( "yisimple stringA}3<div/>Nqa[B;@yk{\"key\":1}")), /***/ 44798);
+    }
+    return fn($a3, $a4, $a5) => $a3;
+  }
+
+}
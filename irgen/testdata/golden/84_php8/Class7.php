@@ -0,0 +1,138 @@
+<?php
+class Class7 extends Class0 {
+
+  const CONST2 = "Y4_<h1>ok</h1>u;";
+  /** @var tuple(bool,bool,float,bool,Class3,Class7,(bool|(int[])),int) */
+  private static $field8;
+  /** @var (tuple((string|float),(int|Class5),(?Class0),callable(int,float):float,bool,Class4,bool,(int|float),int,int,Class2,bool)[]) */
+  public $field9; //
+  /** @var (int[]) */
+  protected $field10 = array(
+    -1,
+    32137,
+
+  ); //*/
+  /** @var Class5 */
+  private $field11 = null;
+  /** @var (int|string) */
+  protected static $field12 = '<div/>';
+  /** @var float */
+  protected $field13;
+  /** @var string */
+  public $field14 = '0x1f';
+  /**
+   * @return (array<mixed,string>[])
+   */
+  public static function method3() {
+    if (!_visit_function(/* comment */"Class7::method3")) return [
+      [ //*/
+        '0' =>
+          ',.<p>>',
+      ],
+      array(
+        "0" => "0x1f",
+        "2" /**/ => 'w W',
+        "-0" => "TL[|1\000",
+      ), //
+    ];
+
+    /** @var callable(int):string $v0 */ $v0 =	fn($a0) => (chr((int)(((int)(((true)	|| ((!false) || (Class5::CONST0))) || (Class5::CONST0))) & (((new Class6( (("mjs),<p>bQkaRC@") == ((string)json_encode(/**/"``"))) ||	(new Class6(false))->field7,))->field7 === (true)) ? 45253 : (strcmp(((string)((("|b24W<h1>ok</h1>1"
+      == '0x1f')
+      || false) === ((!(false || true))))), "zdx[\"val\"]"))))));
+    return array(
+      array(
+        '-1' => ("2?''a<p>IH"),
+        -1	=>	"k<bハロー・ワールド_",
+        -1 => ('-{"key":1}0'),
+        "-0" => '</p>',
+      ),
+		);
+
+  }
+
+  /**
+   * @param (string[]) $p0
+   * @param callable(int,float,bool):int $p1
+   * @return string
+   */
+  public function method4($p0, $p1) {
+    if (!_visit_function("Class7::method4")) return "%7";
+    $v0
+      = tuple(is_file(('ハロー・ワールド0+\\h')), /**/(int)(strcasecmp(("``````5{\"key\":1}S)<p>324`5{\"key\":1}<p>MgK''"), ("M`ibs&Bid0x1f")) + ((int)(($p1((strlen("q7")), (true	&& (false) ? fmod(((true || true) === (true
+      || false) ? pi() : (((-1) - (21948.293242 /***/ - 21948.293242)))),
+      329.5) : (float_eq3(2.51, (make_nan())) ? (($this instanceof Class0) ? (new Class0())->field2 : (110.5969364252935)) /**/ -
+      (((new Class2("0x1f", '~Y'))->method0() + /***/ (183.22312290109056))) : (true ? (cos(169664.1048135828) + 8.361061529323273e+06) : 509.179339805034))), true))  ** (-9284120)))), 329.5, array(
+      (ltrim(/***/"simple string]Iw24a]]''<h1>ok</h1>",)) ==  basename((implode(/*
+*/"!T;.&~J24l``{\"key\":1}}<h1>ok</h1><div/><h1>ok</h1>",
+        explode(((string)((int)3.3468111852462883e+06)), /**/ ((new Class0())->field4), strcasecmp("W", "/")))) . $this->field14, (string)cosh((_safe_float_div(acos(21948.293242), ((0.6208269956546969 -
+        2.071285054047343e+06) + (sqrt(329.5))))))),
+    ),
+      (true), (!($this instanceof Class0)) || (!((!(("ui]''ts>4</p>-123v></p>") === /* comment */ ("A1\n2[\"val\"]<h1>ok</h1>24BBO4zK"))) || false)), (strcasecmp("q?{x000%gbハロー・ワールド=,7Q", /* comment */ (("9aハロー・ワールドoOw4<h1>ok</h1>0x1f<x;U8)gO*<div/>") . ("<h1>ok</h1>")))));
+    $v1 = new Class1(count(/* comment */array( //*/
+      "Q+7V",
+      is_finite(/*
+*/(21948.293242)),
+    )));
+		try {
+      /** @var (?Interface0) $v2 */ $v2  = new Class6((is_nan(329.5) && (is_file(basename((preg_quote(((new Class7())->field5))), "simple string")))));
+      throw new Exception2((("e``:") . "MB"),  -9284120,);
+    } catch (Exception1 $_iv3) {
+      dump_with_pos(__FILE__, __LINE__, (get_class($_iv3) . (": ")) . $_iv3->getMessage());
+      /** @var (float|Class4) $v4 */ $v4 = new Class4(function () {
+        return 207.04132665597157;
+      });
+    } finally {
+      $v5 /* comment */ = tuple(((make_nan()) /**/ - (2.51)) + 1.643089342113692e+06, strnatcmp("9T\000",  "[\"val\"]n><h1>ok</h1>m,\\r63"), /*
+*/(crc32(("M1\n20"))), new Class7(), [
+        true,
+      ],  (tan((rad2deg((sin(/**/21948.293242))))))  + (_safe_float_div((329.5), (2842.6378),)), new Class6(/***/((levenshtein( ("simple stringq:A<div/>=z/<p></p>N<div/>wQ"), (";+zuy{\"key\":1}ハロー・ワールド[\"val\"]A")) === ((13898)))) || (false)), (false), lcfirst( "\000ハロー・ワールド[\"val\"]I'z4Z]T]C^n''[\"val\"]q([{\"key\":1}"));
+    }
+    {
+      $v6 /**/ =	(new Class0())->field5;
+    }
+    if (is_file($v6)) {
+      throw new Exception2((string)$v6[(int)(-((int)((!false ? (((((new Class6((!(("h:Q" . "k1\n2yP") /**/ === (strrev($v6))))))->field7) && /**/ ((false) || ((is_nan(6.909828267689019) || (false)) || (!is_dir($v6))))) ? (((int)(_safe_int_div((-15450), ((int)(strnatcmp(lcfirst("R") . ('Q:[y-123'), ("t%")) * 14738)))))) : ($p1((int)(_safe_int_div( ((int)((0.6375622393261484) + tan(make_nan()))),
+        /*
+*/(16938 | /*
+*/ ((Class4::CONST1) /* comment */ ^ (((int)(-(-52032)))))))),  329.5, (!(!(false || ((new Class6(false))->field7)))))))) : (sizeof(array(
+        0.0,
+        array(
+          $v6,
+          ((string)(sqrt(2.51,))), //
+          ("[<p>-[''+</p>h{$v6}[`,w24x{$v6}yW7-,1"),
+          $v6
+        ),
+        ((new Class6((is_file('&\\{'))))->field7), // comment
+      )))) * (-47002))))],  128412288);
+    }
+    return $v6;
+  }
+
+  /**
+	 * @param int $p0
+   * @param string $p1
+   * @param float $p2
+   * @return Class5
+	 */
+  public function method5($p0, $p1, $p2) {
+    if (!_visit_function('Class7::method5')) return null;
+    $v0	= new Class7(); //*/
+    dump_with_pos( __FILE__, __LINE__, $p2);
+		try {
+      return new Class5(fn() => $p0, cosh((('{<h1>ok</h1>-123<5X' /**/ ==
+        (preg_quote("t"))) ? ((deg2rad($p2))) : (2842.6378))));
+    } catch (Exception1 $_iv1) {
+      dump_with_pos(__FILE__, __LINE__, get_class($_iv1)  . ": " .
+        $_iv1->getMessage());
+      return new Class5(fn() => (int)((((int)((int)(((int)(_safe_int_mod(strcasecmp(trim(("Xa6mg"), "W;:``@"), (("\"U``<h1>ok</h1>!'',{--G]n,") . ((string)(" Q Z"  . "b1\n2")))), (ord("simple string"))))) - 0)))) * /*
+*/ 9284128),  3.4496783162393277e+06);
+    } finally {
+      dump_with_pos(__FILE__,  __LINE__, "Class7::method5 finally");
+      return new Class5(fn() => ((int)(_safe_int_mod(((int)(-((levenshtein(md5(":pG000000%J[{\"key\":1}Hf=Myq24O", ((new Class6(true))->field7)), "</p>,A{!''{$p0}24\\06</p>"))	& ((strcasecmp((',J'),  self::CONST2)) | /*
+*/ (18201509224))))), ((new Class5(fn() => (int)(((int)((-38691) /***/ - (Class1::CONST0))) + ((int)(_safe_int_div( ((int)(count([
+        false // comment
+      ]) /***/ -  ((int)(51056 - (13109141841))))), ((int)((int)((-9284120) *  255))),)))), (asin($p2))))->method0())))), (0.0));
+    }
+  }
+
+}
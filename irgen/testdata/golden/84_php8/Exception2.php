@@ -0,0 +1,3 @@
+<?php
+class Exception2 extends Exception {
+}
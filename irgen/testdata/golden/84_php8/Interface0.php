@@ -0,0 +1,11 @@
+<?php
+interface Interface0 {
+  /**
+   * @param int $p0
+   * @param bool $p1
+   * @param float $p2
+   * @return callable(bool,float,float):bool
+   */
+  public function interface0_method0($p0, &$p1, $p2);
+
+}
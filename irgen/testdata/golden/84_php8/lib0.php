@@ -0,0 +1,397 @@
+<?php
+/**
+ * @param Class3 $p0
+ * @param bool $p1
+ * @param int $p2
+ * @param int $p3
+ * @param tuple(Interface0,Class1,float,(?string),(int[]),float,string,string,float,float) $p4
+ * @return Class0
+ */
+function lib0_func0($p0, $p1, $p2, $p3, $p4) {
+  if (!_visit_function('lib0_func0')) return null;
+  /** @var (Class3|bool) $v0 */ $v0
+    =  true;
+  /** @var callable():int $v1 */ $v1 =
+    fn() => $p3;
+  try {
+		/** @var (bool|string) $v2 */ $v2 = /* comment */ substr_replace(("j000-")  . '?pll', "pR9J0x1ft\000I{\"key\":1}''o+axp=8%{$p1}",
+      (int)((int)(-(Class0::CONST1))))
+			. "A";
+  } catch (Exception1 $_iv3) {
+    dump_with_pos(__FILE__, __LINE__, get_class($_iv3)	. ": " . $_iv3->getMessage());
+  } catch (Exception2 $_iv4) {
+    dump_with_pos(__FILE__, __LINE__, get_class($_iv4) . (': ')
+      .
+      $_iv4->getMessage());
+  } finally {
+    $v5
+      = "S-123";
+  }
+  return new Class0();
+}
+
+/**
+ * @param bool $p0
+ * @param (Class1|Class2) $p1
+ * @param array<mixed,((?bool)[])> $p2
+ * @param int $p3
+ * @param bool $p4
+ * @return bool
+ */
+function lib0_func1($p0, &$p1, $p2, $p3, $p4) {
+  if (!_visit_function("lib0_func1")) return true;
+  $v0 = array(
+    0  => array(
+      -1 => tuple(addcslashes( (("MHハロー・ワールドQ}!e%WE[\"val\"]vx<h1>ok</h1>9</p>l``01\n2b")  . (bin2hex(((string)true)))), ("''")), (strlen(((string)(22248)))), /**/ /* comment */((true && (Class5::CONST0))
+        || is_readable(("@j''21[\"val\"]"))) ||
+        (((int)(_safe_int_mod( (strcmp("~;6t24|={\"key\":1};''e{$p4}", "\\")), strnatcmp("1\n2simple stringq24gc9i{$p4}``''8B!Z.h", ".L%P [-123m;_0000x1f,j\000``PS@</p>{$p4},S\"@'000")))) == /*
+*/ ((int)((string)(((int)(((int)(-(new Class1(/* comment */60763))->field2)) ** ((int)((-36299) + ((int)(-(-9284120))))))) /*
+*/ ^ /***/ ((int)(((int)(_safe_int_mod(((-65160) & 0), ((int)(-(-1)))))) **	strlen(" .s"))))))), (!(is_writeable("H9i57{$p4}{$p4}000!(<p>0x1f\000%<p>S24>E,[\"val\"][\"val\"]{$p4}"))),
+        (($p1 instanceof Class1) ? $p1 : (new Class1( ((-59534) /*
+*/ & ((int)((new Class1(-4256))->field2  * (-40159)))))))->field4,  "vD", -3626, [
+        2 /**/ =>
+          24626,
+      ], tuple(((($p1 instanceof Class1) ? ($p1) : (new Class1(32291)))->field4 + /***/ (71.52783601317948)), ("2424?{$p4}{$p4}524wI/c{\"key\":1}Vu</p>O/#L<div/>I"), 85.00721281626046), (cos(389.01906582420884)),
+        $p4,),
+      4 => tuple(((new Class7())->field14), /**/ ((int)(-9284128)),	Class5::CONST0, (false), 0.0, '6<p>', (int)((int)(_safe_int_div((-47969), (levenshtein("{ ,>K''0x1f", (long2ip((int)((new Class5(fn() => 5078, 0.0,))->method0())))))))), array(
+        1 => ((int)(strlen(((string)("000)\000000;Y</p>Z6{$p4}{$p4}I,6}o}{$p4}"))) /* comment */ * ((int)(_safe_int_div(/* comment */(55700),  ((int)(((int)(((int)((-6470) + /***/ 9284128)) * levenshtein((ucfirst("d[\"val\"]1\n2Y")),   (" vei^xK``ZN0x1f%m+`S000tR")))) + ((int)(((int)(((int)(-(crc32("n")))) - (Class1::CONST0)))	* ((int)((int)("{$p4}{$p4}<h1>ok</h1><h1>ok</h1>{$p4}0}Wm")))))))))))),
+        "" => 255,
+        5 => Class4::CONST1,
+        "1.5" => ((int)((sizeof([
+          ((string)(false)),
+          ((int)(_safe_int_div((255),  count([
+            5586042190,
+					])))),
+          -2222.9999, //
+        ]) & ((int)(14807))) + (Class1::CONST0)))
+      ),
+        tuple(cos(/***/((($p1 instanceof Class2) ? $p1 : (new Class2( "N000;>V", /***/"1\n2{\"key\":1}~f",)))->method1(new Class1( 60848,), array_filter([
+        "0x1" => ((is_nan(157.07560316916155)) || (true))
+      ], fn($a0) => $a0), null,))), ('Im24"``' . ((string)(true))), ((0.8362349251601516) * (0.0789612879448623 + /***/ ((159978.9674180897) + (((!false) && (","
+        === "Y") ? (((206.66165867638782) * 18.41130457410608)) : (make_positive_inf())	+ (_safe_float_div(0.5377874367387373, 413.547542359796))))))) + 2.910687777309725e+06), /***/6.72830292467507e+06, $p4), //
+			5 => tuple(addcslashes(((string)((new Class6($p4))->method0())), (new Class7())->field14), -7134,	!(lib0_func1( is_dir(Class7::CONST2), $p1, [
+        'key' => array(
+          $p4,
+          $p4,
+          $p4,
+          (false),
+        ),
+				"1.5" => [
+          null,
+          null
+        ],
+      ], -34439, false && /*
+*/ lib0_func1($p4, $p1,  array(
+
+        '1' =>	array(
+
+          $p4,
+          null,
+				),
+      ), 0, (true)))), $p4, 0.38209280204204144, "vD",
+        (strcasecmp(")LS", "0x1f")), [
+        48310 => /**/ 7425,
+        "01"	=> levenshtein((strtolower((string)((-9284120) /*
+*/ & (-17085))))	. /***/ ((string)(2842.6378)), ",j1j?j47g?A"),
+        0 => (strcasecmp(((string)("0x1f6")),  'VQh\'4'))
+      ], tuple((asin((0.13325242237206))) - (233.565334626463),  "E,<p>,",  /* comment */0.00043 - ((21948.293242)
+        + (_safe_float_div(6.9910998360785395e+06, /***/ ((array_sum(array(
+        -9284120,
+        "Wg`` a",
+      ))) -
+        (213.03589584141218)))))),
+        (0.0), /***/(!(true == $p4)) || (!(Class5::CONST0))),
+      1 => tuple("ps>1\n2{$p4}24,\0003",
+        /* comment */(int)(strlen((strtoupper("ZxT.{$p4}[,[<p>2\"T761\n2MMS000",))) + 49077),  $p4, $p4, /**/ exp(((new Class1(ord(' 3' . "l")))->field4)), 'vD',  (int)(((int)(((int)(_safe_int_mod((-29223),
+        (13293622177 & ((new Class5( fn() => 42324, 474.27140420443675))->method0())))))	-
+        ((((int)(13812 + ((strlen(/**/("\000bE24" . "-123W")) | ((int)(((51180)) + ((int)((int)(44340 - 8399704305))))))))) | ((14032) ^	((int)((-1) ** (strnatcmp("w|<" . "<div/>", ("y[fa{$p4}<div/>{$p4}{$p4}")))))))))) +	crc32(trim( ","))), /*
+*/array( //*/
+        "1" => (new Class1( -59270))->field2,
+      ), tuple(((35.640908460786946)
+        - (1.1768636373985186e+06 + (make_nan()))), "'", 369.66938347074426 + (new Class1((((!true) || (false && true)) || /* comment */ is_nan(0.6500428398526956) ? (Class1::CONST0) : ((int)(crc32("<h1>ok</h1>") /**/ **
+        (((int)"") & ((-12850) & ((-27433)))))))))->field4), (acosh(169.94150514284343 * (asinh((make_negative_inf()))))), (true)),
+    ),
+    32744 => array(
+      1 => tuple( "[{\"key\":1}T0x1f18Eel9t!000",  (strnatcmp((basename(addcslashes((ltrim("simple string:dx7", "( )")), "{$p4}'''.<p>h<div/></p>`000[\"val\"]G@HS8\\/ m<p>Y"))  . "4D\000>m"), /***/(Class4::CONST2) . ("1\n2"))), ((strlen(("<h1>ok</h1>_I\\<h1>ok</h1>hV!QG"))) == (Class1::CONST0)),  $p4, (39.837721011580854), "H<h1>ok</h1>D", (sizeof(array(
+
+        make_nan(),
+        (make_nan()),
+        (-55151),
+        "=)~",
+
+      ),)), [
+        "-1" => ((int)(((((int)(sin(make_negative_inf()))) | ((int)(((int)(((int)(12528 + /**/ 20237))	+	(-37709))) * ((false ? (((int)(-128412288))) : ((9693697261)))))))
+          | ((int)(!((float_eq2(38.08193997909838, make_positive_inf())) || (!true))))) *	(-3956))) | (11895015990),
+        "2"	=> ((((((false) || (!(true  &&  false))) && (((int)(0 - 9284128)) === (Class0::CONST1))) || ($p4 === $p4))
+          || (new Class6(!((!false) || ((true || false)))))->field7) ? (((int)(-((int)(((int)(-ord("|?z|*<div/>",)))
+          +  (-255)))))) : (128412288)),
+      ], tuple( (acos((atan(((501.492833422842)) /* comment */ - 0.0) + (atan(205.09760494108065 + 0.0))))), 'swA)',  ((1.0230365159117733e+06 - 21948.293242) - (6.979024650329153e+06))), (round(0.32502788858416043, (int)255)),
+        $p4),
+      1 => tuple(((dirname(" D[\"val\"]~L{$p4}{$p4}{$p4}simple string7b7,")) . (("0x1f 30x1f") . ('000'))),  ((int)7940), $p4,
+        /**/(is_dir( ("``E-1232\000{$p4}"))) /***/ && (!($p1 instanceof Class2)), /*
+*/3.272719964749772e+06, "000",  count([
+        (true),
+        levenshtein(("Y J0x1f;w{$p4}''[") . ("{\"key\":1}{$p4}``{$p4}24simple string{$p4}"), (new Class7())->field5),
+      ]), /* comment */[
+        '2' =>
+          -45134,
+        "2"
+          => -27514,
+        "2" => Class6::CONST0,
+        '-1' => ((int)(((int)(((int)((new Class5(fn() => 9284128, 0.00043))->method0() *	(-9284120))) ** 12305)) - levenshtein("ハロー・ワールド" . (",H<h1>ok</h1>ハロー・ワールドB'&<p>K{}/?}"), "e24(Rbsimple string{$p4}(`isimple stringlHw"))) | ((int)(Class5::CONST0)),
+      ], tuple(0.00043 - deg2rad(2842.6378), "U</p>f-123l8R5[\"val\"])Ss[\"val\"]D1%iB", 329.5), /*
+*/make_positive_inf(), $p4),
+      0 => /*
+*/ tuple(("0x1f3NF[\"val\"]\""), 1684647392, ((new Class6(((new Class6($p4))->field7),))->field7), (!(float_eq3((2842.6378),  ((((((true ? 3.2632667810505084e+06 : (227.88008215653608))) - (223.7513373262817 + (make_nan()))) + 21948.293242) + (_safe_float_div(/**/(310348.3572170184), ((5.336110461561629e+06 + 71.0483591319159) - (44529.1004643352))))) *	((0.00043 - (new Class4(fn() => 0.2739299908890235))->field4) +	(sinh(sinh(make_positive_inf())))))))) || false,  sqrt(array_sum(array(
+        array(
+          (is_readable(/*
+*/"000")) || /**/ (false),
+          true,
+        ),
+      ))),	"6<p>",	/* comment */-12972, array_map(function ($a15) use ($p0) {
+				return (int)(_safe_int_div((-14707),
+          (43160)));
+      },	array(
+        2
+          =>	0.7094970229402981 - /* comment */ ((3.435669702444512e+06) - (2842.6378)),
+        -1 =>	329.5,
+        '01' => (2.51),
+      )),
+        tuple(_safe_float_div(((0.4482786019866805) + ((!(('<p>'
+        . "T\000\000") ==	("-o[N" . /***/ '~k84'))) || ((is_readable(/*
+*/"\000",)	||
+        (new Class6(/* comment */false))->field7) /*
+*/ || ((is_infinite(0.3964306624604218)) || (false))) ? (((pi()) + (_safe_float_div((_safe_float_div( (make_nan()), /* comment */((727596.291898686) -  0.5794195619533513))),  (make_negative_inf()))))) : (2842.6378))),	(((checkdate(-50152, (new Class1(6914537744))->field2, (255))) ? (140.02039773124292) : ((_safe_float_div((1.9730024216384501e+06),  /***/0.9958709909618341)))))), ("LC'u=</p>5''2/m3=vx+beiI{"), ((0.9973105796307402) /***/ * (421.0690563167936 * (((246.3860887292223)  - ((false
+        &&  false ? ((exp(0.0))) : (((new Class6(false))->method0())))))	- (0.00043))))
+        * tan((((329.5) - ((new Class2("N000;>V", '?,'))->method1(new Class1(3722), [
+        "01" => false,
+        'b' => true,
+      ],
+        new Class7())))
+        - ((new Class6(false))->method0())))), /**/ (94.13551388852838),  $p4),
+      4 =>	tuple("LD#n\000AYL<h1>ok</h1>[\"val\"]''000<p>;|o000",
+        (int)(-((int)(_safe_int_div(((int)((Class4::CONST1)	+ ((int)(((Class6::CONST0) & ((strnatcmp(urldecode(/***/"F"), "1\n2")))) -
+        (ord("<p>7k")))))), /**/(14013 ^ (((int)(((int)(-((int)(-(Class4::CONST1))))) - ((int)(9284128 + (128412288))))) |  9284128)))))), (Class5::CONST0),	is_nan(acosh(60.09771595242142 + 0.10613205686829971) + 2842.6378), make_positive_inf(),
+				"000",  (Class1::CONST0) ^ (((new Class6((true)))->field6->method0())), array(
+        "2" => (13860395497),
+        "0x1"	=> (int)(((int)(((int)("''\"{\"key\":1}-123simple string{$p4}A000Q1")) +  ((int)("FSqgHr H\000.`<&\000m[\"val\"]")))) /*
+*/ + 13757044553)
+      ), tuple(/**/(pi() - (asin((0.00043)))), 'us}ハロー・ワールド', (431.56546814573875)), _safe_float_div(exp(2842.6378), (sqrt((atan(/* comment */(_safe_float_div( (make_positive_inf()),  fmod(make_positive_inf(),  /*
+*/2.2648277726014196e+06))),))))), (lib0_func1($p4, $p1, /***/[
+        "key"  => array(
+          (Class5::CONST0) || (float_eq2((atan2(0.00043,  329.5)), deg2rad(make_negative_inf(),))),
+        ),
+        "-1"	=> array( //*/
+          $p4
+        ),
+        0  =>	array(
+          $p4,
+          (!((false) || is_finite(0.8937356809604768)))
+            || (checkdate((Class6::CONST0), (int)((255) - (-255)), (int)(10862 + 9284128))),
+          (is_readable("0{sE=''",)),
+				),
+      ], 19619, false))),
+    ),
+    1 => array(
+			-1 =>
+        tuple(((("r9+r\000JuYZ{\"key\":1}kV'")) .	("</p>bvC")), /***/levenshtein(("v(b"),  "!&RI>,{$p4}"),	$p4,  !((new Class6(/*
+*/$p4,))->field7
+        && false), (0.7729409061798147), '000', (int)(((int)(-((int)(_safe_int_mod( (9284128),	((((-61425) ^ (Class0::CONST1))) & (count(array_keys(array(
+        48822.810162674235,
+      )))))))))) /**/ ** ((int)(-(128412288)))), array(
+        "1e3" => levenshtein(/*
+*/"[\"val\"]&M}2424xハロー・ワールド_H5o<p>simple string{$p4}</p>", strtolower(/**/'<h1>ok</h1>')),
+        "key" => ((int)(_safe_int_mod(((int)(10367 - (255))), /* comment */(sizeof([
+          ($p4 === (lib0_func1(true, $p1,
+            $p2, 128412288, false))),
+        ]))))),
+        '-1' => Class6::CONST0,
+        '0' =>  -9284120
+      ), tuple( 21948.293242 - (exp(0.00043)), "cpk", (((new Class1(15451192070,))->field4) +  (((new Class6(true))->field7
+        &&
+				(!(!(true)))) ? acos( (make_negative_inf())) : ((ceil((18236.83015025937)))))) * 0.44011196916015105), -1, false),
+			2 => /**/ tuple("''",	((int)((-6360) ** /* comment */ ((int)((((Class6::CONST0)) & ((int)(_safe_int_mod(((int)(((int)(((int)(-(63471))) - (new Class5(fn() => -9284120, 404.6340780195339))->method0())) +  128412288)),  /***/((int)(_safe_int_mod((16837), (-5404)))))))) * ((int)(count(array(
+        is_readable("rW"),
+        sqrt(468.4490025897217,),
+      )) ** ((int)(_safe_int_div(((int)(long2ip((int)((int)(_safe_int_mod((-52574), 34838)))))), ((int)(((int)(strlen("<h1>ok</h1>q") - 9284128)) * (strcasecmp(((string)(make_nan())), "I4\0000es_1\n2%,ハロー・ワールド!24"))))))))))))), !(lib0_func1($p4, $p1,	 $p2, 19619, $p4)), (Class6::CONST0) ==  (((int)9138052157)
+				& (9284128)), /*
+*/((0.6688797756446379 -	(314.29035616344385 +  0.0)) - (sinh(/* comment */(0.0)) +  ((-1) + make_positive_inf()))), "<p>", (int)(((int)(-(new Class1((-9284120)))->field2))
+        + ((((lib0_func1($p4, $p1, array( # comment
+        "key" =>	[
+          $p4,
+        ],
+        "2" => [
+          null,
+          !true,
+        ],
+			), 255, /**/$p4) ? strcasecmp(/**/"/6W1;1\000A|NU", /*
+*/ 'z``' .	(',' . "}SC[D")) & (-255) : ((crc32(substr_replace(("{$p4}simple string[0x1fECwRVo)-123V<div/>!Y"), ("XS!" . "->simple stringl"),
+        (int)((int)(0
+        + (30792))), (int)(sizeof([
+        false,
+      ])))))))) ^ (128412288)))), array(
+        3 => (ord(('c)<h1>ok</h1>{*'))),
+        "0" => ((($p1 instanceof Class1)) ? (crc32((string)((5.013142854252205e+06) - (make_nan())))) : (14037)) | ((sizeof([
+          true,
+        ],))),
+      ), /***/ tuple((156.35319503486164), /***/"000U`ZD{\"key\":1}{$p4}</p>T", 329.5), /***/asin(((new Class1((int)(((int)((14957122110) ** ((int)("X[\"val\"]@,l")))) + ((int)((Class6::CONST0) * ((int)((-9284120) - (40010))))))))->field4)), $p4),
+      4 =>
+        tuple(("</p>PxI{\"key\":1}i\000y{\"key\":1}:e|9X"), /***/3846858488,	(!(!(!(is_scalar(((int)(strcmp("simple string", '&B?<h1>ok</h1>X') - ((int)(-(-37887))))))  &&	(false))))), /***/ $p4,
+        asinh((((float_eq3( (((!(!false)) ? ((false || false) ? (329.5 * (make_negative_inf())) : (false ? (526.4185302579712) : 252.87700318716276)) : ((0.8823769209343544)))),  /*
+*/(_safe_float_div((array_sum( array(
+        true,
+        18486190967,
+      ),)),  (-1))),) ? ((4.783995194363923e+06)) : ((((new Class6(false))->field7 ? (_safe_float_div(/**/((2.51 * 0.0) + (asinh(2842.6378))), /* comment */make_positive_inf())) : exp(39.21911922401087 /**/ +  0.5256690238306296)))))) - (4.935648366526308e+06
+        +	(_safe_float_div(make_positive_inf(), /*
+*/(cos( 2.51) + exp(0.00043))))))), "000", (25631),	array(
+				"1.5" =>  (strcasecmp('bnq', "j{$p4}24")) ^ ((int)(((int)(-9284120)) + ((int)(((int)((sizeof([
+          5918991669,
+					0.992570922409139
+        ])) - ((int)(_safe_int_mod(11516, ((int)(_safe_int_div(/**/strcasecmp("<h1>ok</h1>",	"<h1>ok</h1>"), (2822218155))))))))) - ((int)(((int)(13427534188 ** ((int)(9284128 * 9284128))))  - ((int)(strnatcmp("\000?", "N/",)	+ ((int)((((int)(0 - (-18076)))) +
+          ((-63317)	^ (-255)))))))))))),
+				4 => /***/ ((int)((-9284120) - (-1677))) & /**/ (strnatcmp(Class0::CONST0, (("{$p4}U<div/>fn!MGFuhCj") . ("UYZ{$p4}''\"=A{$p4}simple string"))) | 255),
+        "b" => /* comment */ ((int)(((int)(((int)((strcasecmp("570x1f\000c! <div/>k",
+          "E~F^HE,dr<p>|yK48]6Q<p>]v.T<p>Y1\n2xx^"))  +
+          ((int)((strlen( "K:>simple string")) - (8085076966))))) +
+          strlen((("I:")  . ("y,"))))) ** (35907 |	(128412288)))),
+      ), tuple(((new Class6((Class5::CONST0) && ((!true)  || ((!false)))))->method0()	+ 154.0604130818831), "<div/>c24",  (179.52197395788548)),  0.00043,	$p4),
+			1 => tuple(("24ハロー・ワールド24-123000{$p4}000''r{$p4}{\"key\":1}*4r3h{$p4}<p>m/"), 0, /* comment */("VQk)Q{ B)1\n2W") === "24G-123``ハロー・ワールド",
+        (true), /* comment */ 329.5, "vD", (-9284120), [
+        "b" => ((-43634) ^ ((int)(strlen( "h5<p>, wA;O<p>[\"val\"]e.Z\000<div/>n, )y") + ((int)(((int)(-((int)(_safe_int_div(((int)(Class5::CONST0)), /***/((int)(-strlen(",")))))))) - (-64131)))))),
+        '1' => (int)(((int)(_safe_int_div((Class6::CONST0), (strlen(decbin((int)(4113182712 & /**/ (-43574)))))))) ** 255),
+      ],
+        tuple(4391.266262296314,
+        (Class7::CONST2), 2842.6378),
+        floor(ceil( make_negative_inf())), (new Class6($p4))->field7,)
+    ),
+    -1 => [
+      -44289  => tuple((ltrim((((string)((int)((int)((levenshtein(/* comment */"simple string",  "58") & count(/* comment */array(
+        566.8226423391469,
+        true,
+      ))) * ((int)((Class6::CONST0)  **	((int)(_safe_int_div( 0, /*
+*/2237))))))))) . "1*1\n2"), "</p>{\"key\":1}cW{$p4}")), (int)(_safe_int_mod( (Class5::CONST0 ? (int)(((int)((strcmp("B{\"key\":1}simple string\000y", ("000")	. "BbP_p")) - (8262 | (Class0::CONST1)))) ** (-1)) : -38883), ((int)((25056) * (Class0::CONST1))),)),  /* comment */(checkdate( (new Class5(fn() => (ord("gRsoe")), (0.7244204416066402)))->method0(),
+        (Class4::CONST1), ord(("Ww<div/>ハロー・ワールド'7]/1\n2<p>''0w}-K@dI]]10x1f-12324A''=")))),  (!($p1 instanceof Class2))  && (!(!(Class5::CONST0))),  (0.70638941161854), "6<p>", /***/ (int)(64954 + (-19706)),  array(
+        "-1" => -1,
+        5 => ord(dirname(";iK,AXtw[qwN</p>Ud{O<<div/>~-")),
+        "2"
+          => (int)(((ord(("k b"))) | /**/ (0 & ((int)(sizeof(array(
+
+          72.37247517653746,
+          3.6056665277894204e+06,
+        )) + (strlen("!z[\"val\"]ハロー・ワールドp{$p4}0x1f{000H|_kk{\"key\":1},")))))) - 62480), //
+      ), tuple(round((array_sum(array(
+        (6798156366),
+        329.5 + (2842.6378),
+      ))), (int)((int)678902.1486460739))  + exp((ceil((437.91589181909904 + 125.54348016293315)))), /**/ /*
+*/(string)("6a[y)PU</p>t``<h1>ok</h1>"), (new Class2("N000;>V", /**/"{$p4}2TZfk, "))->method0() * 329.5),  /*
+*/(cos(/* comment */3.297925203206835e+06)), ($p4 == $p4) /*
+*/ || (is_infinite(((new Class2("\\2e", "k"))->method0()))	&&	is_writeable("{$p4} 24000 /_.J.q7simple stringz"))),
+		],
+  );
+  $v1
+    = new Class7(); //*/
+  $_iv2 =
+    0;
+  while ($_iv2++ < 5) {
+    list($v3) = array(
+			'9s{"key":1}Ap.',
+    );
+    dump_with_pos(__FILE__,  /*
+*/__LINE__, $v3); # comment
+	}
+  try {
+
+    return (true);
+
+  } catch (Exception2 $_iv4) {
+    dump_with_pos(__FILE__, __LINE__, get_class(/*
+*/$_iv4) . ": " . $_iv4->getMessage());
+    return (lib0_func1(is_readable("{$p4}5``)yCq<div/>4"), $p1, $p2,
+       -2697, (Class5::CONST0),)  && (true == $p4));
+	}
+}
+
+/**
+ * @param Class1 $p0
+ * @param string $p1
+ * @param tuple(tuple(bool,tuple(float,float,float),(?bool),bool,(?(int[])))) $p2
+ * @param ((string[])|float) $p3
+ * @param Interface0 $p4
+ * @param string $p5
+ * @param (?string) $p6
+ * @param Class2 $p7
+ * @param (?int) $p8
+ * @return Class6
+ */
+function lib0_func2($p0, $p1, $p2, $p3, $p4, $p5, $p6, $p7, $p8) {
+	if (!_visit_function("lib0_func2")) return null;
+  $v0 = '<div/>';
+  if ((((!is_readable((string)(new Class5(function () {
+    return (count([
+
+      true,
+    ]));
+  },  (-2222.9999)))->method0(),)) || (Class5::CONST0)) && file_exists(((string)(false)) .  (("},simple string].L\000simple string0<h1>ok</h1>9L|wO<div/><div/>W;,Z\000,,1\n2") .
+    (((string)(is_null($p6) ? ((string)$p5[(strcmp( $p5, ':G</p>\'\'',))]) : ($p6))) . ((string)(($p6) ?? ("000.:")))))))) {
+    /** @var (?bool) $v1 */ $v1 /*
+*/ = (true);
+  }
+  return new Class6( (((!(true)) || (($p5 ===
+    $p5) && true)) && (false)));
+}
+
+/**
+ * @return Generator<int,string,int,float>
+ */
+function lib0_gen0() {
+  if (!_visit_function("lib0_gen0")) return 1.449036676578498e+06;
+  $v0 = 10343466797;
+	$v1 = new Class4(function () {
+    return ((("]/\000T,ハロー・ワールドVy\\5l0x1fZ") === ("0x1fy-123XQsimple string<h1>ok</h1>-57m G") ? ((((new Class1(Class0::CONST1,))->field4)	+ (2842.6378))) /**/ + (2.51  + /***/ (make_nan())) : (make_nan())));
+  }); # comment
+  yield (int)(_safe_int_mod(ord((strtolower((("n.:z z''  ") . /*
+*/ basename( ("w=gx Pc9<div/>)")))))	. ((string)(-51636))), (-255))) => (new Class0())->method1(fn() => 0) . (addcslashes( preg_quote((bin2hex("[\"val\"]`</p>" . ((string)true)))),
+		/*
+*/(rtrim(("=fx*#"), /* comment */(ucwords(rawurlencode('&wa0B') . ("b_B</p>24 !Z</p>w;^1\n2  ''''4<p>G")))))) . 'P8');
+  return (false ? 21.236005629194075 : _safe_float_div((acos((floor(deg2rad(19.884959721754633))),)), /**/ cosh((atan(168.33916298361783)))));
+}
+
+/**
+ * @return Generator<int,bool,bool,int>
+ */
+function lib0_gen1() {
+  if (!_visit_function("lib0_gen1")) return -255;
+  $v0 = tuple(/*
+*/array(
+    0  =>  31.125446778824415,
+    " 1" => 926461.3867510525, # comment
+    "1" =>	-1,
+		"01" =>  329.5
+  ));
+  dump_with_pos( __FILE__, __LINE__, /***/array(
+    60416,
+  ));
+  $v0 = $v0;
+	dump_with_pos( __FILE__, __LINE__, /***/"``nd''``/ a-123.N`jO*"); //
+	/** @var (?bool) $v1 */ $v1 = yield (false);
+  /** @var (?bool) $v2 */ $v2	= yield (true) &&
+    (!(((!true)	||  (false)) || (!((!(Class5::CONST0))	|| (Class5::CONST0)))));
+  try {
+    return (int)(27711 * ((int)(-(crc32( "j99")))));
+  } catch (Exception1 $_iv3) {
+    dump_with_pos(__FILE__, __LINE__,
+			get_class($_iv3,) . ": " . /*
+*/ ($_iv3->getMessage()));
+		return ((int)((int)(((int)(((int)(round(make_positive_inf(),  (int)((int)(((int)(-(count(array(
+      -255,
+      false
+    ))))) ** (-56673)))))) ** (sizeof(array(
+      'fB',
+      115.15247946726119
+    ))))) + (0 & count( array(
+      rawurlencode((Class0::CONST0)),
+      (!(checkdate(-60203, -18117, 128412288) || ((bool)(is_null($v2) ? true : ($v2)))))  || (!(!(!(false &&  (true))))),
+		))))));
+  }
+}
+
@@ -0,0 +1,227 @@
+<?php
+/**
+ * @param tuple(callable(int,float):bool,tuple(int,int,float,(int|bool),callable(float):string,(?float),callable(bool):string,float,int,float,Class0),callable(float,int,bool):int,(?int),Class1) $p0
+ * @param float $p1
+ * @param int $p2
+ * @param tuple(tuple(bool,int,(?string),int),float,bool,Class6,(bool|Class3),int) $p3
+ * @param bool $p4
+ * @param (Interface0|int) $p5
+ * @param (bool|int) $p6
+ * @param (?bool) $p7
+ * @return (int|Class4)
+ */
+function lib1_func0($p0, $p1, &$p2, $p3, &$p4, $p5, $p6, $p7) {
+  if (!_visit_function("lib1_func0")) return -55074;
+  $v0 = new Class1( (((((true) /*
+*/ && (!(((int)(((int)((-9284120) - ((int)(strnatcmp( 'MYho)Y',
+    ",4") /***/ +
+    (Class6::CONST0))))) **	((int)((-47565)  + (Class0::CONST1))))) === ($p4 === false ? ((int)(is_int($p6) ? ($p6) : (sizeof([
+    0.9115937125620647
+	])))) : (count(array_flip(array(
+    'oT',
+  )))))))) || (false /***/ || (is_writeable(/***/(substr_replace(long2ip((int)(-21276))	. (gettype(' ')), (string)((false ? 329.5 : make_positive_inf())	-  8.39010718745311),	(int)((int)((2.266226807333177e+06 - 421906.1646827919) - (acosh(350.6386527688436)))), (int)((int)(is_int($p5) ? $p5 : -65189)))),)))) ? (count(/*
+*/array_filter(/**/[
+    (is_int($p6) ? floor( 503.5928184057668) : 0.0) +	0.0,
+    (int)(((int)(is_int($p6) ? $p6 : (strnatcmp( dirname(/* comment */"D<h1>ok</h1>"), ("\000"))))) + /**/ ((int)(((int)(((-29045) |	(((int)(is_int( $p5) ? $p5 : -9284120)) |	(new Class5(/**/fn() => -1,	562.3380344729653))->method0())) - (7874565562))) ** ((int)(-(-9284120)))))),
+    423.4464164289124,
+  ], function ($a0) use ($p6) {
+		return (!is_int($p6)) /***/ || (((checkdate(39587, 5084442015, 8798500171) || (is_dir('{8'))) ||	((false))) || (!(((((new Class7())->field14)) === ("<div/>;v*6 simple string1\n2_[-123>-123C;{\"key\":1}"))
+      || is_finite(0.7419641016191658))));
+  }))) : (((int)((-5490) +	ord(strtolower((rawurldecode((new Class7())->field5))))))))));
+
+	$v0->field3 = "18";
+  $v1
+    = array(
+
+    5 => ((new Class0())->field5)
+  );
+  $p4 = $p4;
+  return ((int)(_safe_int_div((-255), (strcmp((('zU') . (new Class7())->field14), ("<p>IVGF"))))))	^ ((!(new Class6($p4))->field7 ? (Class1::CONST0) : (((int)((strcmp(bin2hex(("{$p4}WKwx0x1f\"<h1>ok</h1>!0x1f,<p>b1D=24") . bin2hex(rawurldecode("\"tv<h1>ok</h1>")),),  "0x1f,1,_#C,D44<"))	+ (((new Class1((0)))->field2) ^ strnatcmp(Class0::CONST0,	(md5(("\000W*OfQ``{$p4}{Y<h1>ok</h1>1\n2<p>m<h1>ok</h1>-123gxL,2QP"))))))))));
+}
+
+/**
+ * @param Class1 $p0
+ * @param tuple(float,(float[]),string,int,Class3,bool,float,Interface0,(string|Interface0)) $p1
+ * @param string $p2
+ * @param callable(float,int):string $p3
+ * @param ((string[])|string) $p4
+ * @param callable(int,string):bool $p5
+ * @return string
+ */
+function lib1_func1($p0, $p1, $p2, $p3, $p4, &$p5) {
+  if (!_visit_function( 'lib1_func1')) return "<h1>ok</h1>";
+  /** @var callable(bool,float,float):int $v0 */ $v0 /* comment */ = fn($a0, $a1, $a2) => ((int)(((int)(((int)(-((int)((-6518) * ((int)(-(-9284120)))))))  + (-46620))) * /***/ ((!(!($p2 /*
+*/ === $p2)) ? ((new Class5(fn() => (strcmp($p2, ((string)128412288))) & sizeof([
+    true # comment
+	]),	(0.49513779134246033)))->method0()) : (3988410837)))));
+  $p0->field4 += deg2rad(69.13749911189443);
+  switch (((string)(is_string($p4) ? ($p4) : '</p>PY24'))) {
+    case ("``"):
+      $p0->field4
+        -= ((50.953488617790576) * (_safe_float_div((((((new Class6((!(!true)) || ($p5(255, /* comment */$p2))))->method1( $p0, array(
+				' 1' => ((is_dir('!24') && (true && true))) ==	(('<p>245#' === $p2) && (false && false))
+      ), new Class7())	+ (266.49100821098205)) - 28.83367783256472)) + (sinh( make_negative_inf()))), 157.73649338726898)));
+      $_iv1 = /*
+*/ 0;
+      while ($_iv1++ < 10) {
+        /** @var (?bool) $v2 */ $v2  = /**/ (true);
+        list($v3, $v4)	= array(
+					array_count_values(array(
+            0.00043,
+            [
+							(strcasecmp($p2, "\000")),
+              ((int)((strcasecmp(/* comment */htmlentities((string)$p2[(int)(Class5::CONST0)]), ("ハロー・ワールド" . (((string)((new Class6(false))->field7))))))  - (is_null($v2) ? ((int)(_safe_int_mod(crc32(/* comment */(Class0::CONST0)),  ((int)(((int)((-38592) * (-6077))) +	($v0((!(!true)),
+                (make_nan()), ((false ? 2.51 : (0.0)))  + /**/ (0.6219461160793079)))))))) : (int)(-(18813661206))))),
+              (int)(((int)(((is_bool($v2)) ? ((13219151290 | (((true && true) && (new Class6(true))->field7) ? (-62170) : ((int)((int)(_safe_int_mod(/**/128412288, 54833)))))) & levenshtein($p3(2842.6378, 4724476895), /*
+*/ bin2hex('["val"]M'))) : ((int)(((int)($p5(3489617060, $p2))) /***/ - ((int)(_safe_int_mod(/**/((int)(((int)(_safe_int_div(crc32( $p2,),  ((int)(8815490667 + 22952)))))  +
+                ((int)(((int)(_safe_int_mod((-2142), 12878282004))) - ((int)((-9284120) - (-4399))))))),   0)))))) -	((int)(((new Class2("N000;>V", "{6 V"))->method1($p0, [
+                "01" => !false # comment
+              ], /*
+*/new Class7())) + $p0->field4))))
+                + ((-29467))),
+            ],
+          )),
+          array(
+            (((("EV|1\n2\000{$p2}")) === $p2) ? ((int)(_safe_int_mod(((int)(_safe_int_div( ((int)((((int)((-1)  + ((int)(_safe_int_div(((int)(((int)(strlen("l{ k")
+              + sizeof(array(
+
+              -1,
+            )))) -
+              ((14976)))), ((int)("c?,8simple string :``{$p2}")))))))) +  ord($p2))),  (Class1::CONST0)))), ((int)(($v0(((((string)stripslashes(/*
+*/"[\"val\"]")) ===	('["val"]')) || (!file_exists($p2))),	(exp((make_negative_inf()))), _safe_float_div(2842.6378, 495.43952354643943))) ** ((int)(_safe_int_div(((int)(_safe_int_mod( (sizeof(array(
+              !(true || false),
+              (array_sum(array(
+                true,
+                "H[\"val\"]",
+              )) - (2.2497221250686594e+06)),
+            ))),  12161260800))),	strcmp( ("M3M)Z{$p2}"), /***/$p2))))))))) : (((int)((count(array(
+              strnatcmp(Class4::CONST2, "\000Xh"),
+            ))) + 63032))  | (strnatcmp("GWEh``", /***/((string)$p2[Class0::CONST1]))))),
+						strcmp($p2,
+              ((string)(is_string($p4) ? $p4 : $p2)) . ('</p>'),),
+            strlen("{$p2}[\"val\"]0x1f0x1f+YR24|\000"),
+            count(array_map(fn($a3) => ((_safe_float_div(/**/(-1), (_safe_float_div(0.0,  (round(((new Class6(true))->method0())))))))
+              + (((!((!(true	&& true)) && ((float_eq3(496.4855128915577,	137.63543631580697)) || (true	|| true)))) ==	$a3 ? (asin((((3.3428555192346484e+06) - 21948.293242) + /**/ (2.51)))) : (((-2222.9999)) - ((-2222.9999) * ((!false ? (583.0078461959451 - 180.05874159359297) : (0.0 - 8.001845707601527e+06))
+							- (atan2(29.846793036424874, 118.9242296485315)))))))), /**/ [
+              true,
+              (false),
+              !(($p0 instanceof Class4)),
+              ((!(!(new Class6((!((new Class6(true))->field7)),))->field7))  ||	((is_nan(0.005128186070524989)) || ($p5(6411648935, ((string)(is_string($p4) ? $p4 : '`' . ",,cn")))))),
+            ])),
+          )
+        );
+        dump_with_pos(__FILE__, __LINE__, $v3);
+        dump_with_pos(__FILE__,	 __LINE__, $v4);
+      }
+  }
+  try {
+    return "Z#H=</p>";
+  } catch (Exception2 $_iv5) {
+    dump_with_pos(__FILE__, __LINE__, get_class($_iv5) /**/ . /*
+*/ ': ' . $_iv5->getMessage());
+    return '\'\'';
+  } finally {
+    dump_with_pos(__FILE__,  __LINE__,  "lib1_func1 finally");
+  }
+}
+
+/**
+ * @param bool $p0
+ * @param Class5 $p1
+ * @param float $p2
+ * @param (int|bool) $p3
+ * @param tuple(callable(string):bool,Interface0,Class1,(string|bool),string,tuple(Class5,string,(bool|float))) $p4
+ * @param callable(float,bool):bool $p5
+ * @param Interface0 $p6
+ * @param tuple(float,(Class0|string),Class3,int,callable():int) $p7
+ * @param float $p8
+ * @return float
+ */
+function lib1_func2($p0, &$p1, $p2, $p3, $p4, $p5, $p6, $p7, $p8) { //*/
+  if (!_visit_function('lib1_func2')) return 49.82276160216302; //
+  $v0 =
+    new Class2("0x1f", ("<h1>ok</h1>E%#D;"));
+
+  /** @var (float|string) $v1 */ $v1 = (rawurlencode(("{$p2}t^}</p>cx~j~[\"val\"]")));
+  /** @var bool $v2 */ $v2 = (!(!(file_exists((string)"x^i000") ||	(is_scalar(/* comment */make_negative_inf()) &&	(!(((bool)(is_bool( $p3) ? ($p3) : (!((false) && false)))) || (((bool)(is_bool($p3) ? ($p3) : $p0))	&& ((false))))))))) && (($p0	==	$p0) == (float_eq3( $p2,  3.8803273242548476e+06)));
+  $v3 = 0.0;
+  return atan2($p2, $p2,);
+}
+
+/**
+ * @param int $p0
+ * @param callable(string,bool):float $p1
+ * @param bool $p2
+ * @param float $p3
+ * @param Interface0 $p4
+ * @param bool $p5
+ * @param tuple((?(int[])),callable(float,int,int):string,int,float,string) $p6
+ * @param string $p7
+ * @return string
+ */
+function lib1_func3($p0, &$p1, $p2, &$p3, $p4, $p5, $p6, $p7) {
+  if (!_visit_function(/**/"lib1_func3")) return "yDl%\000`";
+  /** @var callable(int,bool,bool):bool $v0 */ $v0 = function ($a0, $a1, $a2) use ($p1, $p4) {
+    return (!(!(file_exists(Class0::CONST0))));
+  };
+  $v1 = [
+    ',',
+    "<div/>:C~ハロー・ワールド",
+  ];
+  return $p7;
+}
+
+
+/**
+ * @param string $p0
+ * @param string $p1
+ * @param string $p2
+ * @param (bool|int) $p3
+ * @return Generator<int,float,string,string>
+ */
+function lib1_gen0(&$p0, $p1, $p2, $p3) { // comment
+  if (!_visit_function("lib1_gen0",)) return 'Y ,WT';
+  $v0 = tuple((!(Class5::CONST0)), new Class2("N000;>V", (Class7::CONST2)), new Class1(strlen(trim(("a%W"), implode(Class7::CONST2, /* comment */ [ // comment
+    ("<div/>"
+      . '000') . (sha1("*rC", true)),
+    ("=KC")
+  ])))), /*
+*/ [
+
+    true,
+    !(false	&& (((new Class6((new Class6(/**/false,))->field7))->field7 && ((is_file((new Class7())->field14)) || (!array_key_exists(bin2hex("8_{,#:"), [
+      21948.293242
+    ]))))	|| (!true))),
+    true
+  ], function ($a0) use ($p0) {
+    return strcasecmp(((string)json_encode( ("24=-123r2{$a0}"))),
+      (long2ip((int)((new Class1((int)(((count([
+
+      "''Wy#|\"",
+    ]) | ((int)(_safe_int_div(1062743394,  6471))))) - (-53912))))->field2))));
+	},	fn() => (string)(!(Class5::CONST0)), (!file_exists(urldecode(/**/(sha1( ("e''000ハロー・ワールド-6\000Oa]q.\000gz{\"key\":1}FW*\" QP``-")))))));
+	$v1 = [
+    fn($a1, $a2) => ("Qx\000{$a1} "),
+    function ($a3, $a4) use (/* comment */$p3, $p0) {
+      return ((string)$a3[(int)(is_int($p3) ? $p3 : $a4)]);
+    },
+    fn($a5, $a6) => $a5
+  ];
+  /** @var (?float) $v2 */ $v2	=  atan((atan2(/**/2.51, ((_safe_float_div(sin((180.57731558053575)), ((-1) * 2.51))) * (round( atan2(2842.6378 + (0.0	+ 329.5), /***/make_positive_inf()), (int)(-26078)))))));
+	$v3 =	tuple(function () use ( $v1, /* comment */  $v0) {
+    return (new Class6(((float_eq3((0.16316007039503552),
+      /*
+*/(acos( (2.51)))))  &&	(((true)	&& (((false) == (float_eq3( (2.51),  atan2(0.00043, /**/ 348569.2436405805)))))) === (Class5::CONST0)))))->field7;
+  }, new Class7(), new Class7(),  ((int)(_safe_int_mod((-9284120), /* comment */strcmp(/*
+*/(basename("q;K1\n2m\000</p>~=n:5 G?T5")), ("`="),)))));
+  yield ((int)(((int)is_scalar(/**/(">")  .
+    (((("^000]''Tpハロー・ワールドM,simple string9/") . addcslashes(decbin(/* comment */(int)9284128), "MiS 0")) . ("0x1f-123%%simple string<div/>2'<div/>q r[24.u<div/>i"))	. ('V_|a')))) -  (strcasecmp(/* comment */'\\',	((Class0::CONST0)) .
+    chr((int)(((((array_key_exists("H",	 array(
+    19376
+  )))) === false) ? Class4::CONST1 : ((9284128))))))))) /**/ => (new Class1( (strlen( (Class0::CONST0),))))->field4;
+  /** @var (?string) $v4 */ $v4  = yield ((int)(128412288 - /***/ (crc32((string)((string)json_encode(str_split("}", (int)(_safe_int_div(((int)(_safe_int_mod(24734, ((int)(is_int($p3) ? ($p3) : 128412288))))),  /***/((int)((-9284120)	- (false ? 12119 : -1)))))))))))) | strnatcmp(rtrim( sha1("8o(il<h1>ok</h1>Mlj3<h1>ok</h1><h1>ok</h1><h1>ok</h1>Gc; IK''@\000",),  Class0::CONST0), dirname(("</p>"  . ((((string)(((Class5::CONST0)) /**/ || (base64_encode("-123")  === ("i K cl<div/><h1>ok</h1>-123ハロー・ワールド%{\"key\":1}<div/>3``"))))) .	("e x^o/\"Q]b,p<h1>ok</h1>};{\"key\":1}U1!-123[\"val\"]*XhmUH"))),)) =>  asinh((make_negative_inf()) +  0.8232572115899137,);
+  yield ((new Class1(strcasecmp((">gz</p>@"), "1_")))->field4);
+  $p0 = "{\"key\":1}w,";
+  return (((("{o")) /* comment */ . ("<p>'<div/>j\000J[tpsimple stringx1\n2@uu")) . ("/d(p''"));
+}
+
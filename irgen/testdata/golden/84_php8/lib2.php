@@ -0,0 +1,254 @@
+<?php
+/**
+ * @param (?Interface0) $p0
+ * @param (int|string) $p1
+ * @param Class7 $p2
+ * @param (bool|(int[])) $p3
+ * @param (string[]) $p4
+ * @param Class5 $p5
+ * @param tuple(int,tuple(float),(Interface0[]),callable(bool):string,int,bool,bool,int,Class1,int) $p6
+ * @param (int|bool) $p7
+ * @return Class7
+ */
+function lib2_func0($p0, $p1, &$p2, &$p3, $p4, $p5, $p6, $p7) {
+  if (!_visit_function("lib2_func0")) return null;
+  /** @var callable(int,bool,bool):int $v0 */ $v0 = fn($a0, $a1, $a2) => $a0;
+	$v1 = "``yq[\"val\"]b";
+  $_iv2 =
+		0;
+  while ($_iv2++	< 7) {
+    /** @var (?Class1) $v3 */ $v3 =	new Class4(function () use (/* comment */$p3) {
+      return ((new Class1(13431,))->field4	- (0.4555806957863075));
+    },);
+  }
+  switch ((((array_key_exists((" "),
+    [
+    (acosh( 329.5)),
+    ((new Class5(function () use ($p1, /*
+*/$p4) {
+      return ((int)(is_int($p1) ? ($p1) : ((int)(58092 +  ((-32596))))));
+    }, (cos(285.9617709844241,)),))->method0()),
+    ('l'),
+  ])) ? make_positive_inf() : 0.00043 * (((((((0.0 + (cos((273.97111256995805))))) + acos((329.5)))) * (make_negative_inf())) * (make_nan())) - make_positive_inf())))) {
+    case sinh((((is_nan(/*
+*/(0.3956865995123992 - (208.1936331758178)))) ? ((2842.6378)) : ((((!is_file( (string)(is_string($p1) ? ($p1) : "ハロー・ワールド8Jj`G</p>{\"key\":1}U^6@l<h1>ok</h1>000"))) || (false) ? make_nan() : (new Class6(true))->method0())))))):
+      switch (-27121) {
+      }
+      /** @var (float|int) $v4 */ $v4 /* comment */ = (int)(((int)(-(-42698))) /*
+*/ ** ($p5->method0()	^ /**/ sizeof(array(
+        [
+					((" Tpa21G9[\"val\"]<div/>l|+4<p>Cハロー・ワールド24000<p>,") /*
+*/ ==	((string)255) ? (((asin(0.5507171929254773,)) /**/ * (ceil((497.9016513019891 + 213.10769688054995))))) : ((_safe_float_div(0.24809416876533097, /*
+*/(((21948.293242) - (2842.6378 * 336.8807113422485))	+	(21948.293242)))))),
+          (new Class6( false))->method0(),
+        ],
+      ))));
+      break;
+    case 0.0:
+
+      $v5	= tuple((!((Class5::CONST0) && /*
+*/ (!(((int)(255 * ((int)(((int)(is_int($p7) ? ($p7) : (7236406732 &  ((-255)))))  - 61002)))) == (-20123))))) &&	(checkdate((int)(new Class6(Class5::CONST0))->field7, (int)(((int)(-levenshtein( 'ハロー・ワールドkB9b2', ("\000M<div/>E"))))	+ ((int)(11776408323	* (((int)(((((int)(8280231825 - (-2404))) | ((int)(255 +	6353497905))) ^ (Class6::CONST0)) - (Class4::CONST1)))	^ ((new Class5(fn() => 255, 526.1380470558428))->method0()))))), ($v0(((int)((int)((Class0::CONST1) + ($v0(((int)(_safe_int_div(9284128, (-56509)))), Class5::CONST0, ((new Class6(/***/true))->field7),))))), true,  false)))), "\\3 n]kt K&E A0x1f", ")H24",	array(
+        ((strnatcmp("q''DRz", "%op``O+<h1>ok</h1></p>T#y") == 5756309019) || (true)),
+      ), (((new Class6((true)))->field7) ? ((new Class2("\\2e", ",{\"key\":1}0. @O +000bknIc m !"))->method1(new Class1(((int)((int)((Class4::CONST1) -  (count(/**/array(
+        true,
+			)))))) | (new Class1((int)(is_int($p1) ? $p1 : (int)(-6268))))->field2), [
+        "1 "  => /*
+*/ checkdate(/**/(int)(is_int(/***/$p7,) ? $p7 : ((int)((-27460)	**	9284128))), (int)(-(strcmp( "<h1>ok</h1>",  "fxyt4\000"))),  ((new Class1(40498))->field2)) /*
+*/ || (is_writeable("Z\000hg") && (true)),
+        "01" /**/ =>  (array_key_exists((';a#</p>PA'), [
+
+          atan2((ceil(363785.4998477043)), 284.2994194437995),
+          array(
+            ((2.6091039430994154e+06 + /***/ (344.4464971714934)) *  (new Class1(-9284120))->field4),
+            (0.00043),
+
+            146.69460212933402,
+            (_safe_float_div(551.5167497325178, /* comment */(make_nan()))) * /**/ (pi()),
+
+          ),
+				])),
+        '1 ' => (!(false &&	(Class5::CONST0))),
+      ], null,)) : (_safe_float_div(((fmod((make_positive_inf()),  0.0))	+ ((make_negative_inf()) * 948.316206110498)), 16.609493723223082))), fn($a3, $a4, $a5) => -27953, (true), "</p>X000.i-123%}simple string^/!", array(
+        0 /*
+*/ => (2.51),
+				2 =>	(227.372317887974),
+      ));
+
+      $v6 = [
+        (array_sum([
+          (strcasecmp(Class7::CONST2, ("Q-12324mt000B\000E}es"))),
+          Class0::CONST1,
+        ])) /***/ +
+          (-1),
+        (0.42227008329126725),
+        (1.0066225581856722e+06),
+        lib1_func2(!((Class4::CONST2) == ((string)((-255) /*
+*/ == crc32(Class7::CONST2)))),	 $p5, 329.5,  $p7,  tuple(fn($a6) => ((($a6 == $a6)) && (!(true))) && ((Class5::CONST0) || (!((is_writeable( $a6) && is_readable($a6)) || ((Class5::CONST0)
+          && ((false ||	(true))))))),
+          new Class6(!(Class5::CONST0)),
+          new Class1(/* comment */(21469)), /* comment */ /* comment */!(!('*-z <div/>c'
+          == /***/ ("URハロー・ワールドl0x1fT_cu''P}-@"))), (Class0::CONST0), tuple(new Class5(function () use ($p5, $v1,
+          $v0) {
+          return (int)(-((int)(_safe_int_mod(((int)(false
+            && false)), /**/  (-28394))))); // comment
+        }, -2222.9999),
+					/*
+*/("XrPQ,<h1>ok</h1>\000Uu @,<h1>ok</h1>= q7`"), (float_eq3(((float_eq3(cos((585.1943584662445)), (-2222.9999)) ? (0.05937732048211509) : floor(/**/(172.8264745019186)))), (((2842.6378) *  (sqrt(0.9045318512962737)))  -
+          (2.51)))))),
+          function ($a10, $a11) use ($p4, /* comment */$p1) {
+          return $a11;
+        },	new Class6((!(new Class6((((int)(-(false ? -25244 : (128412288))))  == $v0(255, true, true)) || (false),))->field7)), /*
+*/  tuple(floor(pi() -  0.8587784464198892), /***/"L.~1\n2i\000 i''6vsimple string7ハロー・ワールドe", new Class3(), ((int)(0
+          - ((int)(")M000fL_")))), fn() => (int)is_infinite((((0.8348809264569138) + exp(329.5)) -	(-2222.9999)))), 329.5)
+      ];
+      break;
+		case 0.00043:
+      $p2->field5
+        = "4{\"key\":1}J</p> \"d6`0x1f1rE,''";
+      $v7 = -48631;
+      break;
+
+    default:
+	}
+  try {
+    dump_with_pos(__FILE__,
+       __LINE__, iterator_to_array(lib0_gen1(),	false,));
+    $p2->field0 = function ($a12, $a13, $a14) use ($v0, /***/ $p7) {
+      return $a14;
+    };
+    throw new Exception0(('ハロー・ワールド'), 27068,);
+  } catch (Exception0 $_iv8) {
+    dump_with_pos(__FILE__, __LINE__, (get_class($_iv8,)	. ": ") . $_iv8->getMessage());
+    list($v9, $v10, $v11, $v12, $v13, $v14) = tuple((0.15011059740893876), 61164, array_filter(array(
+      "1.5" => false,
+      -1 =>  (new Class6((new Class6((((bool)(is_bool($p3) ? ($p3) : (!true)))  && (new Class6(true))->field7)))->field7 || (((((lcfirst( "-123") == ("000=/B_e:@sYZj-123</p>")))	&& array_key_exists(("]" . "?*"), [
+        true,
+        128412288
+      ])) && (float_eq2((sinh((2842.6378	+ (-2222.9999)))), /**/(fmod(/***/((-2222.9999) -  65.6114331304849), 2.4140368355870955e+06 -  596.6487330490035)))))  && ((!(!(("ハロー・ワールド:T1\n2simple stringeHsimple string") === /*
+*/ ("]o``o1D000-B=0x1f[>0x1fハロー・ワールドb<p>AR{\"key\":1}''pRI")))) && (((is_dir( ")")) &&	(!(is_file( "X-123f000d")))) || (((!true) && /*
+*/ (false &&	false)) || ((true))))))))->field7,
+
+      2 => /**/ ((bool)(is_bool($p7) ? $p7 : Class5::CONST0)) || ((!(((bool)(is_bool($p3) ? ($p3) : (((new Class6(true))->field7) && false))) || /**/ (!(true	&& ((true && (!false))	&& (!(false && false))))))) &&	(((!(((int)((int)(((int)(9284128 +  (-1)))	+ $v0(/* comment */11376, false, false,))))
+        == ((int)(10256465046 * ((int)(-((-11691) /***/ ^	64731))))))) || ((Class5::CONST0)))	&& is_infinite(_safe_float_div( 4.781663043744807, (((new Class1( 3887267568))->field4) + lib1_func2(false,	$p5, 0.9229495922471268,	/***/$p7, tuple(fn($a21) => true, new Class6(false,), new Class1(-255), "ハロー・ワールド",   "</p>=6", tuple($p5, "Yk*A",  5.825140611931642e+06)), function ($a22, $a23) {
+        return $a23;
+      },  new Class6(/* comment */true), tuple(401.85725228489343, new Class0(), new Class3(), -21813, /***/fn() => -3899),  2842.6378)))))),
+      "1" =>  (!is_dir(/**/trim(substr_replace(((':') .
+				("=ハロー・ワールドY0x1f-123#<p>e %<div/>")),
+         (ltrim('s}qハロー・ワールドeJ', "K",))	.  ("!,},<div/>cen0x1fMI9|q16000vt+N(oOf1\n2.&21yTvハロー・ワールドa''"), (int)((int)(((-29836) & (-5543)) - (true ? (10395170229) : 7160))),  (int)((int)(is_int($p7) ? $p7 : (32428)))), basename("[\"val\"]", /*
+*/(strtoupper("z\000{")))))),
+    ), function ($a24) use ($p6,  $p5, $p0) {
+			return ((true)
+        || (Class5::CONST0)) &&	(!checkdate((int)(_safe_int_mod(((new Class6(true))->field7 ? (-255) : (((int)(_safe_int_mod(((int)(true || false)), 63744))))),  ((int)((Class6::CONST0) ** (strlen("-")))))), (ord(trim(((new Class7())->field14)))), (Class6::CONST0))); // comment
+    }), new Class4( function () {
+      return (round(3.5595245746055488e+06, (int)((int)((-255) - 35276))));
+
+    }), "NWJ\000+1\n2", 15155534282);
+    dump_with_pos(__FILE__,	__LINE__, $v9);
+    dump_with_pos(__FILE__, __LINE__,	$v10);
+    dump_with_pos(__FILE__, __LINE__, $v11,);
+    dump_with_pos(__FILE__, __LINE__, $v13);
+    dump_with_pos(__FILE__, __LINE__, $v14);
+  }
+  $p2 /*
+*/ = new Class7();
+  $p3 = array(
+		(strnatcmp("{\"key\":1}", ((new Class7())->field14))),
+    (int)(!is_null(/***/$p0)),
+    (new Class5(fn() => ((int)((new Class5(fn() => -9284120,  21948.293242))->method0() -  49936)), cosh((make_negative_inf()))))->method0() | ((int)((!is_dir(/* comment */(string)(!is_nan(2842.6378)),))  && (((bool)(is_bool($p7) ? ($p7) : (((bool)(is_bool($p3) ? $p3 : ((!((Class5::CONST0) && (true || false))))))))))))
+  );
+  return $p2;
+}
+
+/**
+ * @return (float|int)
+ */
+function lib2_func1() {
+  if (!_visit_function("lib2_func1")) return 48435;
+  $v0 = new Class3();
+  $v1	= new Class7();
+  $v2 =
+    tuple(/**/[
+    null,
+    (2.953109438367462e+06),
+  ], /**/'1Y{', strlen(("8``SXHZE+~\\f';PPpv{\"key\":1}, o0x1f")), /* comment */ new Class7(), new Class7(), /***/(decbin((int)2188579692,)
+    . ("0,Rwy,B<h1>ok</h1>")),  (count(array(
+		array(
+      537.6955660189064,
+      0.3803192745840333,
+    ), // comment
+		2842.6378
+  ))), new Class0(),	new Class5(fn() => (int)(ord((((string)((string)((int)(-52737)))) . ('{"key":1}' . "F\000^h")),) -
+    35945),  (((($v0 instanceof Class3)) ? ((317958.2608622198 * (0.6498688265201997 - ((((-2222.9999) * ((cosh(-1))
+    - (329.5)))) * (0.00043)))) -	224.87792926484266) : ((21948.293242))))));
+  return make_negative_inf();
+}
+
+/**
+ * @return float
+ */
+function lib2_func2() {
+  if (!_visit_function("lib2_func2")) return 89838.12299530384;
+  $v0 = 27386; //*/
+	/** @var (int|float) $v1 */ $v1 = (((Class5::CONST0) ||
+    (Class5::CONST0)) ? (fmod(_safe_float_div(80.22454149448332, ((pi()	+ (tan(deg2rad(148.63420258538423 * (-1),)))) + /**/ ((new Class6(Class5::CONST0))->method0()))),	/* comment */make_positive_inf() - ((((cosh(/***/1.872635680060618e+06)) - /* comment */ 2.245808044333192e+06) + (new Class6(checkdate(15693,	-1, /* comment */ 14399),))->method0()) - ((((true /***/ && ((is_readable("I",) && false)  && ((Class5::CONST0) && (true)))) ? (((sqrt((0.09218853736281155))))
+		- ((true ? ((-2222.9999) + (ceil(-2222.9999))) : (149.41402234488618)))) : ((asin(309.8490364316783 + 0.0) - (467.84885354766266)))))  * ((0.050149378890930005)
+    + (0.00043)))))) : (new Class1((((false) == (!(is_scalar(make_nan())))) ||	(!true) ? Class6::CONST0 : (levenshtein(('_Y["val"]H' . ("nd<div/>eek<div/>uEULxo")), /* comment */"0x1f-123simple string+f<p>%Pq!ioハロー・ワールド")))))->field4 + (((array_sum([
+    (int)(((int)2.51) -
+      ((int)(_safe_int_div((count(array(
+      "sS\".I",
+      true, # comment
+    ))), /* comment */ crc32("<div/>"))))),
+    false,
+    (!(is_finite(make_nan()))) || (false || ((false  || true))),
+    (make_nan()) - /* comment */ (make_nan()),
+  ],)  -  21948.293242) * make_positive_inf()) - (((21948.293242) - ((_safe_float_div(0.2633880176928475,  796257.7768664717,))	-	(((new Class6(false))->method0() -
+    (2.51)) + (((-1) - (cosh( 197.0917432606364) -
+    ((cos(2.51)))))	*
+    (0.0))))) + array_sum(array(
+    ((string)(('2cn</p>') . ("24"))),
+  )))));
+  return 2.51;
+}
+
+/**
+ * @param int $p0
+ * @param callable(float,bool,string):bool $p1
+ * @param Class0 $p2
+ * @param (float|string) $p3
+ * @param (?string) $p4
+ * @param int $p5
+ * @param int $p6
+ * @return bool
+ */
+function lib2_func3($p0, $p1, &$p2, $p3, $p4, $p5, $p6) {
+	if (!_visit_function("lib2_func3")) return true;
+  $v0	= (float)((398.65100875853506 *  (new Class2("<h1>ok</h1>", '``'))->method1(new Class4(fn() => sinh((deg2rad(/* comment */765.7034161104244)))), [
+    "01" =>  is_infinite(2842.6378), // comment
+		"b"  => (((!(((!true)
+      || (!false)) == (float_eq3((0.8874376093210412), 187705.86958915822))))	== ((!(((-48552) === 9639) || $p1(0.00043, true,
+      /***/'k-123]24{"key":1}['))) && (!checkdate($p6, $p6, /***/ 9402)))) && is_readable("{$p6},``n{$p6}")),
+  ], new Class7())) * ((-1) *
+    ((float)(is_float( $p3) ? ($p3) : (((float)(is_float($p3) ? $p3 : (2842.6378) + (16.292036121283164))))))));
+  $v1
+    = (float)(((4.00539394531126e+06) +	((float)(is_float($p3) ? $p3 : (((float)(is_float($p3) ? $p3 : (_safe_float_div(((float)(is_float($p3) ? ($p3) : (3.5256544885664023e+06))), (((false) || ((false && false)
+    || (!false))) ? (sinh($v0)) : (-2222.9999)))))))))) + asin(/*
+*/(new Class1($p6))->field4));
+  try {
+    $v2	= new Class6(false);
+  } catch (Exception1 $_iv3) {
+    dump_with_pos(__FILE__, /* comment */__LINE__, /**/get_class($_iv3) .  ": " .	$_iv3->getMessage(),);
+  } catch (Exception1 $_iv4) {
+    dump_with_pos(__FILE__, __LINE__, get_class($_iv4) .  ": "
+      . $_iv4->getMessage());
+  }
+  /** @var (?(float[])) $v5 */ $v5 = array(
+		$v1,
+    ((260.44427313549653) + /*
+*/ ((float)(is_float($p3) ? ($p3) : (329.5)))),
+  );
+  $v6 = (int)(int)((Class0::CONST1) **
+    (crc32("# [\"val\"]Zx0gK-123S")));
+  $p2 =  new Class0();
+  return (is_dir( "ui1\n2<p><h1>ok</h1><h1>ok</h1>0x1fRs>0x1f{$v1}"));
+}
+
@@ -0,0 +1,147 @@
+<?php
+require_once __DIR__ . '/fuzzlib.php';
+require_once __DIR__ . '/Interface0.php';
+require_once __DIR__ . '/Class0.php';
+require_once __DIR__ . '/Class1.php';
+require_once __DIR__ . '/Class2.php'; // comment
+require_once __DIR__ . '/Class3.php';
+require_once __DIR__ . '/Class4.php';
+
+require_once __DIR__ . '/Class5.php';
+
+require_once __DIR__ . '/Class6.php';
+require_once __DIR__ . '/Class7.php';
+require_once __DIR__ . '/Exception0.php'; # comment
+require_once __DIR__ . '/Exception1.php'; // comment
+require_once __DIR__ . '/Exception2.php';
+require_once __DIR__ . '/lib0.php'; //*/
+require_once __DIR__ . '/lib1.php';
+require_once __DIR__ . '/lib2.php';
+function func0() {
+  /** @var bool $v0 */ $v0 = (!(!(!("81J~J</p>" === ("<p>" . ("^xQ"))))));
+  $v1 = tuple([
+    null,
+    !true,
+    Class5::CONST0, # comment
+  ]);
+  $v2 =  new Class5(function () use ($v0) {
+    return ((new Class1((int)(((int)(-((int)(-((new Class6(true))->field7 ? (((int)(((int)(17926173746 * 30041))  + /*
+*/ (false ? 0 : (-8929))))  & /***/ ((int)(((int)'*bK') ** (27169 | 49493)))) : ((int)(12332842346 ** (((int)(65237 *
+      16047)) & (count(array(
+      false,
+      "u-123W"
+    ))))))))))) ** /*
+*/ (0))))->field2);
+  },  (asin((rad2deg(((new Class6(((new Class6(/* comment */false,))->field7 ||	(float_eq3(0.8491269964028613, 0.0)))))->method0()),)) * (_safe_float_div((11.291235955512136), (0.5484700661783724))))));
+  /** @var bool $v3 */ $v3  = /**/ Class5::CONST0;
+  /** @var bool $v4 */ $v4 = (new Class6($v3,))->field7 || (!(float_eq3(((new Class6($v3))->method1(new Class1(crc32(((string)("T|<div/>" . "h1\n20")) .  ('I'))),  array(
+    "" => (true),
+    "0x1" => ((float_eq2(((-2222.9999) - asinh(-1)), (make_negative_inf()))) || true) &&	(new Class6($v3))->field7
+  ), null)),	8.775889427958043)));
+
+  /** @var bool $v5 */ $v5 /* comment */ = (new Class6( (is_dir("{$v4}<p>0x1fX^rOpハロー・ワールド"))))->field7;
+  $v6	= new Class0();
+  if ((is_nan((atan((1.4430817918780837e+06) + (new Class6(/**/!(true  || false)))->method0())) - (_safe_float_div(round(21948.293242), /* comment */((((rad2deg( 21948.293242))  * /***/ (asinh(614.2634981430759)))) + /***/ 0.934223754465754)))))) {
+
+    dump_with_pos(__FILE__, __LINE__, /*
+*/array(
+      (true && (!(($v5 == (!(((int)(-(strcmp((string)false, "-123)" . "</p>w4.")))) == ((int)(((int)(_safe_int_mod(((int)(((int)false) - (crc32('["val"]')))), strnatcmp('?``',
+        /* comment */"'")))) - (Class1::CONST0)))))) &&
+        is_finite((deg2rad( asin(/* comment */43.62138826396434))) + (2.46679258550579e+06))))),
+
+      !(!(new Class6((false)))->field7)
+
+    ));
+  }
+  dump_with_pos(__FILE__, __LINE__,  [
+		'' => $v5,
+		'1e3' /*
+*/ => $v5
+  ]);
+  dump_with_pos(__FILE__,	__LINE__, $v0);
+
+  dump_with_pos(__FILE__, __LINE__, /***/ $v3);
+}
+
+function func1() {
+  $v0 =  10285938125;
+  $v1 = array(
+    "2"	=> /**/ (int)(_safe_int_div(((int)(((int)((((int)((((!(!(is_writeable(/* comment */'Fyq~hT'))))
+			&&
+      is_writeable( '<p>'))) && /**/ is_scalar((new Class6(false,))->field7))) ^
+      ord( ("<div/>yB24+.*;b~"))) /**/ + (Class0::CONST1))) **	(strcmp("%", /**/"[\"val\"]s!-<p>``_{\"key\":1},</p>{\"key\":1},!")))), levenshtein((new Class7())->field14, ('Jj') .  "5Xd<p>="))),
+    '1' => (60178),
+    2 => ((((is_scalar(/*
+*/",")) ? ((int)(_safe_int_mod((Class1::CONST0),  (ord(/***/"#")),))) : ((int)((((int)((((int)((-255) - /*
+*/ 6646)) ^ /*
+*/ ((13440) | (-9284120)))
+      + (((!(true  && ((false || false))) ? ((int)(_safe_int_mod(((((int)(_safe_int_div(16292, 9347109201)))) &  (count([
+      true,
+		]))), (-1)))) : 10352770929))
+      ^ /* comment */ (-47810)))) & (41404)) + 58464)))) & (new Class4(function () {
+      return ((329.5) +	make_positive_inf());
+
+    }))->field2)
+  );
+  $v2 /**/ = new Class1(count(array_keys(array(
+    array(
+      (547.2058744543833),
+    ),
+    make_nan(),
+    1.6219058248518226e+06,
+
+    (new Class5(function () {
+      return strcmp("ハロー・ワールド", '``');
+    }, /**/make_negative_inf()))->method0(),
+  ))),);
+  $v3 =	new Class7();
+  $v4 = new Class0();
+  foreach ($v1 as &$v5) {
+    $v5 = (int)(_safe_int_div(((int)((((53104) ^ ((int)(_safe_int_div(((int)(-(((int)is_file(bin2hex("}{\"key\":1}\000d"))) | ((int)(((int)(-((new Class1(43816,))->field2 & ((int)(-255))))) - (-32227)))))), ((int)((((($v2 instanceof Class1)) ? ((levenshtein( ("p*" . "D"), ',["val"]'))) : (((int)(((int)(crc32("simple string{F")	+ ((int)(-20964)))) + ((int)(((int)(9284128 - ((-35750)))) +	count(array(
+      true
+    )))))))) ^ ((int)((-44143)	*  (ord(strtoupper('<h1>ok</h1>'),)))))  + ((count(array_filter(array_keys([
+      (true
+        && true)
+    ]), fn($a0) => (is_file(" 3L",)))))))))))) & /***/ ((int)(((int)((strcmp("<p>", ("-12397q-123`24W!<div/>o"))) - ((int)(-((int)((((int)((ord('{"key":1}'))  **	(23818)))	& 19180)	* ((int)((50847)
+      + crc32("xG0x1f5 *"))))))))) **
+      ((int)(0.00043)))))	* (20938))), ((int)((-63157)	+ sizeof(array_keys( array_keys(/**/array(
+      $v4->method1(fn() => 1661) . ("``"),
+      ((int)((((int)((9284128 & (-20432))
+        * /***/ (-4200))) |
+        ((int)(_safe_int_div(((int)((-3093) + /* comment */ 63322)), (12749018112	| 7585208879),)))) + /**/ 41370))
+    ))))))));
+  }
+  unset($v5);
+  foreach ($v1 as $v5) {
+    dump_with_pos(__FILE__,
+      __LINE__, $v5);
+  }
+  dump_with_pos(__FILE__, __LINE__, $v1);
+  /** @var bool $v6 */ $v6 = false;
+  $v2 = $v2;
+
+  dump_with_pos(/*
+*/__FILE__, __LINE__, $v0);
+	dump_with_pos(__FILE__, __LINE__, $v1);
+}
+
+function main() {
+  try {
+    func0();
+  } catch (Exception $e) {
+    dump_with_pos(__FILE__,	/***/__LINE__, get_class($e) .  ": " . $e->getMessage());
+  } catch (TypeError $e) {
+    dump_with_pos(__FILE__, __LINE__, get_class($e));
+  }
+  try {
+    func1();
+	} catch (Exception $e) {
+
+    dump_with_pos(__FILE__, __LINE__,
+      get_class($e) . ': ' . ($e->getMessage()));
+  } catch (TypeError $e) {
+    dump_with_pos(__FILE__, __LINE__, get_class($e));
+  }
+}
+
+main();
@@ -16,6 +16,33 @@ func canConstexprInitialize(t ir.Type) bool {
 		return true
 	case *ir.ArrayType:
 		return canConstexprInitialize(t.Elem)
+	case *ir.NullableType:
+		return canConstexprInitialize(t.X)
+	case *ir.UnionType:
+		return canConstexprInitialize(t.X) && canConstexprInitialize(t.Y)
+	default:
+		return false
+	}
+}
+
+// containsClassType reports whether the values of type t can hold class instances.
+func containsClassType(t ir.Type) bool {
+	switch t := t.(type) {
+	case *ir.ClassType:
+		return true
+	case *ir.ArrayType:
+		return containsClassType(t.Elem)
+	case *ir.TupleType:
+		for _, elem := range t.Elems {
+			if containsClassType(elem) {
+				return true
+			}
+		}
+		return false
+	case *ir.NullableType:
+		return containsClassType(t.X)
+	case *ir.UnionType:
+		return containsClassType(t.X) || containsClassType(t.Y)
 	default:
 		return false
 	}
@@ -27,6 +54,10 @@ func canDump(t ir.Type) bool {
 		return true
 	case *ir.ArrayType:
 		return canDump(t.Elem)
+	case *ir.NullableType:
+		return canDump(t.X)
+	case *ir.UnionType:
+		return canDump(t.X) && canDump(t.Y)
 	default:
 		return false
 	}
@@ -49,9 +80,33 @@ func typeLess(t1, t2 ir.Type) bool {
 	case *ir.ClassType:
 		return t1.Name < t2.(*ir.ClassType).Name
 	case *ir.FuncType:
-		return t1.Name < t2.(*ir.FuncType).Name
+		t2 := t2.(*ir.FuncType)
+		if t1.Name != t2.Name {
+			return t1.Name < t2.Name
+		}
+		return t1.String() < t2.String()
+	case *ir.GeneratorType:
+		return t1.String() < t2.String()
 	case *ir.ArrayType:
-		return typeLess(t1.Elem, t2.(*ir.ArrayType).Elem)
+		t2 := t2.(*ir.ArrayType)
+		if typeLess(t1.KeyType(), t2.KeyType()) {
+			return true
+		}
+		if typeLess(t2.KeyType(), t1.KeyType()) {
+			return false
+		}
+		return typeLess(t1.Elem, t2.Elem)
+	case *ir.NullableType:
+		return typeLess(t1.X, t2.(*ir.NullableType).X)
+	case *ir.UnionType:
+		t2 := t2.(*ir.UnionType)
+		if typeLess(t1.X, t2.X) {
+			return true
+		}
+		if typeLess(t2.X, t1.X) {
+			return false
+		}
+		return typeLess(t1.Y, t2.Y)
 	case *ir.TupleType:
 		t2 := t2.(*ir.TupleType)
 		if len(t1.Elems) < len(t2.Elems) {
@@ -69,31 +124,27 @@ func typeLess(t1, t2 ir.Type) bool {
 		return false
 	case *ir.EnumType:
 		t2 := t2.(*ir.EnumType)
-		if len(t1.Values) < len(t2.Values) || t1.ValueType.Kind < t2.ValueType.Kind {
-			return true
+		if t1.ValueType.Kind != t2.ValueType.Kind {
+			return t1.ValueType.Kind < t2.ValueType.Kind
 		}
-		if len(t1.Values) > len(t2.Values) {
-			return false
+		if len(t1.Values) != len(t2.Values) {
+			return len(t1.Values) < len(t2.Values)
 		}
+		// The value types are identical, so are the values dynamic types.
 		for i, v1 := range t1.Values {
 			v2 := t2.Values[i]
+			if v1 == v2 {
+				continue
+			}
 			switch v1 := v1.(type) {
 			case string:
-				if v1 < v2.(string) {
-					return true
-				}
+				return v1 < v2.(string)
 			case int64:
-				if v1 < v2.(int64) {
-					return true
-				}
+				return v1 < v2.(int64)
 			case float64:
-				if v1 < v2.(float64) {
-					return true
-				}
+				return v1 < v2.(float64)
 			case bool:
-				if !v1 && v2.(bool) {
-					return true
-				}
+				return !v1 && v2.(bool)
 			default:
 				panic(fmt.Sprintf("unexpected enum value: %T", v1))
 			}
@@ -117,11 +168,39 @@ func typesIdentical(t1, t2 ir.Type) bool {
 
 	case *ir.FuncType:
 		t2, ok := t2.(*ir.FuncType)
-		return ok && t1.Name == t2.Name
+		if !ok || t1.Name != t2.Name {
+			return false
+		}
+		if t1.Name != "" {
+			return true
+		}
+		// Anonymous function types are compared structurally.
+		if len(t1.Params) != len(t2.Params) || !typesIdentical(t1.Result, t2.Result) {
+			return false
+		}
+		for i, p1 := range t1.Params {
+			if !typesIdentical(p1.Type, t2.Params[i].Type) {
+				return false
+			}
+		}
+		return true
+
+	case *ir.GeneratorType:
+		t2, ok := t2.(*ir.GeneratorType)
+		return ok && typesIdentical(t1.Key, t2.Key) && typesIdentical(t1.Value, t2.Value) &&
+			typesIdentical(t1.Send, t2.Send) && typesIdentical(t1.Return, t2.Return)
 
 	case *ir.ArrayType:
 		t2, ok := t2.(*ir.ArrayType)
-		return ok && typesIdentical(t1.Elem, t2.Elem)
+		return ok && typesIdentical(t1.KeyType(), t2.KeyType()) && typesIdentical(t1.Elem, t2.Elem)
+
+	case *ir.NullableType:
+		t2, ok := t2.(*ir.NullableType)
+		return ok && typesIdentical(t1.X, t2.X)
+
+	case *ir.UnionType:
+		t2, ok := t2.(*ir.UnionType)
+		return ok && typesIdentical(t1.X, t2.X) && typesIdentical(t1.Y, t2.Y)
 
 	case *ir.TupleType:
 		t2, ok := t2.(*ir.TupleType)
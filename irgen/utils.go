@@ -15,6 +15,48 @@ func canBeNull(n *ir.Node) bool {
 	}
 }
 
+// returnType returns a type of the values returned by the fn return statements.
+// For the generator functions it differs from the fn result type.
+func returnType(fn *ir.FuncType) ir.Type {
+	if typ, ok := fn.Result.(*ir.GeneratorType); ok {
+		return typ.Return
+	}
+	return fn.Result
+}
+
+// inheritedMethods returns the methods c inherits from its parents.
+// If a method is overridden, only the most derived version is returned.
+func inheritedMethods(c *ir.ClassType) []*ir.FuncType {
+	var result []*ir.FuncType
+	seen := make(map[string]struct{})
+	for p := c.Parent; p != nil; p = p.Parent {
+		for _, m := range p.Methods {
+			if _, ok := seen[m.Name]; ok {
+				continue
+			}
+			seen[m.Name] = struct{}{}
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+func newObject(c *ir.ClassType, args []*ir.Node) *ir.Node {
+	return &ir.Node{
+		Op:    ir.OpNew,
+		Args:  args,
+		Value: c.Name,
+		Type:  c,
+	}
+}
+
+// describeException returns a string that contains the e class name and its message.
+func describeException(e *ir.Node) *ir.Node {
+	className := newSimpleCall("get_class", e)
+	message := ir.NewCall(ir.NewMemberAccess(e, "getMessage"))
+	return ir.NewConcat(ir.NewConcat(className, ir.NewStringLit(": ")), message)
+}
+
 func newSimpleCall(fn string, args ...*ir.Node) *ir.Node {
 	return ir.NewCall(ir.NewName(fn), args...)
 }
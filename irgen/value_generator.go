@@ -25,17 +25,17 @@ func toEfaceSlice[T any](xs []T) []any {
 	return result
 }
 
+// generateUniqueValues returns n distinct values produced by f.
+// The values are returned in the order they were generated.
 func generateUniqueValues[T comparable](n int, f func() T) []T {
 	set := make(map[T]struct{}, n)
-	for len(set) < n {
+	slice := make([]T, 0, n)
+	for len(slice) < n {
 		x := f()
 		if _, ok := set[x]; ok {
 			continue
 		}
 		set[x] = struct{}{}
-	}
-	slice := make([]T, 0, len(set))
-	for x := range set {
 		slice = append(slice, x)
 	}
 	return slice
@@ -85,6 +85,19 @@ func (g *valueGenerator) StringValue() string {
 	return s.String()
 }
 
+// IntKeyValue returns a small int, so the array keys collide more often.
+func (g *valueGenerator) IntKeyValue() int64 {
+	if randutil.Chance(g.rand, 0.1) {
+		return g.IntValue()
+	}
+	return int64(randutil.IntRange(g.rand, -1, 5))
+}
+
+// StringKeyValue returns a string that is never converted to an int array key.
+func (g *valueGenerator) StringKeyValue() string {
+	return randutil.Elem(g.rand, stringKeyValues)
+}
+
 func (g *valueGenerator) BoolValue() bool {
 	return randutil.Chance(g.rand, 0.5)
 }
@@ -134,3 +147,28 @@ var stringLitValues = []string{
 	`{"key":1}`,
 	`["val"]`,
 }
+
+// stringKeyValues contain numeric strings that are not
+// in the canonical int form, they are not converted to int keys.
+var stringKeyValues = []string{
+	"",
+	"a",
+	"b",
+	"key",
+	"01",
+	" 1",
+	"1 ",
+	"1.5",
+	"1e3",
+	"-0",
+	"0x1",
+}
+
+// intStringKeyValues are converted to int keys: $a["1"] is $a[1].
+// PHP_INT_MAX is not used as a key: appending after it throws an Error.
+var intStringKeyValues = []string{
+	"0",
+	"1",
+	"-1",
+	"2",
+}
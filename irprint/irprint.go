@@ -1,7 +1,6 @@
 package irprint
 
 import (
-	"bufio"
 	"bytes"
 	"fmt"
 	"io"
@@ -11,16 +10,26 @@ import (
 
 	"github.com/quasilyte/phpsmith/ir"
 	"github.com/quasilyte/phpsmith/phpdoc"
+	"github.com/quasilyte/phpsmith/randutil"
 )
 
-// TODO:
-// - printing with random formatting (using externally provided rand object)
-// - testing for both modes (random and normal)?
-
 type Config struct {
 	// Rand is used to add randomized formatting to the output.
 	// If nil, no randomization will be used and the output will look like pretty-printed.
 	Rand *rand.Rand
+
+	// AlternativeSyntax makes the control flow statements use
+	// the alternative syntax, like "if (): ... endif;".
+	AlternativeSyntax bool
+
+	// ExoticLiterals enables the rarely used literal forms: hex, octal and binary ints,
+	// digit separators, floats in exponent form, heredoc, nowdoc and escaped strings.
+	// If Rand is nil, every literal that has an exotic form is printed that way.
+	ExoticLiterals bool
+
+	// SourceMap collects the positions of the printed nodes if not nil.
+	// Use FprintFile to get the file names and root node indexes recorded.
+	SourceMap *SourceMap
 }
 
 var modifyOpLit = map[ir.Op]string{
@@ -40,10 +49,25 @@ var modifyOpLit = map[ir.Op]string{
 	ir.OpNullCoalesce:  "??",
 }
 
+// FprintFile prints a PHP file that consists of the given root nodes.
+func FprintFile(w io.Writer, filename string, nodes []ir.RootNode, config *Config) {
+	p := &printer{
+		config: config,
+		w:      newPositionWriter(w),
+	}
+	p.sourceMap.file = filename
+	p.w.WriteString("<?php\n")
+	for i, n := range nodes {
+		p.sourceMap.root = i
+		p.printRootNode(n)
+	}
+	p.w.Flush()
+}
+
 func FprintRootNode(w io.Writer, n ir.RootNode, config *Config) {
 	p := &printer{
 		config: config,
-		w:      bufio.NewWriter(w),
+		w:      newPositionWriter(w),
 	}
 	p.printRootNode(n)
 	p.w.Flush()
@@ -58,16 +82,18 @@ func SprintNode(n *ir.Node) string {
 func FprintNode(w io.Writer, n *ir.Node, config *Config) {
 	p := &printer{
 		config: config,
-		w:      bufio.NewWriter(w),
+		w:      newPositionWriter(w),
 	}
+	p.enterMember("", n)
 	p.printNode(n)
 	p.w.Flush()
 }
 
 type printer struct {
-	config *Config
-	w      *bufio.Writer
-	depth  int
+	config    *Config
+	w         *positionWriter
+	depth     int
+	sourceMap sourceMapState
 }
 
 type printFlags int
@@ -86,24 +112,131 @@ func (flags printFlags) NeedNewline() bool {
 }
 
 func (p *printer) indent() {
+	if p.config.Rand != nil && randutil.Chance(p.config.Rand, 0.1) {
+		for i := 0; i < p.depth/2; i++ {
+			p.w.WriteByte('\t')
+		}
+		return
+	}
 	for i := 0; i < p.depth; i++ {
 		p.w.WriteByte(' ')
 	}
 }
 
+// newline ends the current line.
+// With randomized formatting, it can be a CRLF, an empty line
+// or a line comment followed by a line break.
+func (p *printer) newline() {
+	r := p.config.Rand
+	if r == nil {
+		p.w.WriteByte('\n')
+		return
+	}
+	switch r.Intn(20) {
+	case 0:
+		p.w.WriteString("\r\n")
+	case 1:
+		p.w.WriteString("\n\n")
+	case 2:
+		p.w.WriteString(" " + randutil.Elem(r, lineComments) + "\n")
+	default:
+		p.w.WriteByte('\n')
+	}
+}
+
+// space writes a token separator that can't be omitted.
+// With randomized formatting, it can be any whitespace sequence or a comment.
+func (p *printer) space() {
+	r := p.config.Rand
+	if r == nil {
+		p.w.WriteByte(' ')
+		return
+	}
+	switch r.Intn(16) {
+	case 0:
+		p.w.WriteByte('\t')
+	case 1:
+		p.w.WriteString("  ")
+	case 2:
+		p.w.WriteByte('\n')
+		p.depth += 2
+		p.indent()
+		p.depth -= 2
+	case 3:
+		p.w.WriteString(" " + randutil.Elem(r, blockComments) + " ")
+	default:
+		p.w.WriteByte(' ')
+	}
+}
+
+// optionalSpace writes a token separator that can be omitted.
+func (p *printer) optionalSpace() {
+	r := p.config.Rand
+	if r == nil {
+		return
+	}
+	switch r.Intn(10) {
+	case 0:
+		p.w.WriteByte(' ')
+	case 1:
+		p.w.WriteString(randutil.Elem(r, blockComments))
+	}
+}
+
+// chance reports whether an optional randomized formatting should be applied.
+// It's always false if the formatting is not randomized.
+func (p *printer) chance(probability float64) bool {
+	return p.config.Rand != nil && randutil.Chance(p.config.Rand, probability)
+}
+
+// Comments must never contain "?>" as it would end the PHP code block.
+var (
+	lineComments = []string{
+		"//",
+		"// comment",
+		"# comment",
+		"//*/",
+	}
+	blockComments = []string{
+		"/**/",
+		"/* comment */",
+		"/*\n*/",
+		"/***/",
+	}
+)
+
 func (p *printer) printRootNode(n ir.RootNode) {
+	if p.config.SourceMap == nil {
+		p.printRoot(n)
+		return
+	}
+	start := p.w.Pos()
+	p.printRoot(n)
+	p.addRootSourceMapEntry(n, start)
+}
+
+func (p *printer) printRoot(n ir.RootNode) {
 	switch n := n.(type) {
 	case *ir.RootFuncDecl:
+		p.enterMember("", n.Body)
 		p.printFuncDecl(n)
+	case *ir.RootDeclare:
+		p.enterMember("", n.Value)
+		p.w.WriteString("declare(" + n.Directive + "=")
+		p.printNode(n.Value)
+		p.w.WriteString(");")
+		p.newline()
 	case *ir.RootRequire:
-		p.w.WriteString("require_once __DIR__ . '/" + n.Path + "';\n")
+		p.w.WriteString("require_once __DIR__ . '/" + n.Path + "';")
+		p.newline()
 	case *ir.RootStmt:
+		p.enterMember("", n.X)
 		flags := p.printNode(n.X)
 		if flags.NeedSemicolon() {
 			p.w.WriteByte(';')
 		}
 		if flags.NeedNewline() {
-			p.w.WriteString("\n")
+			p.newline()
 		}
 	case *ir.RootClassDecl:
 		p.printClassDecl(n)
@@ -113,23 +246,64 @@ func (p *printer) printRootNode(n ir.RootNode) {
 }
 
 func (p *printer) printClassDecl(decl *ir.RootClassDecl) {
-	p.w.WriteString("class " + decl.Type.Name)
-	p.w.WriteString(" {\n")
+	classType := decl.Type
+	switch {
+	case classType.Flags.IsInterface():
+		p.w.WriteString("interface ")
+	case classType.Flags.IsAbstract():
+		p.w.WriteString("abstract class ")
+	case classType.Flags.IsFinal():
+		p.w.WriteString("final class ")
+	default:
+		p.w.WriteString("class ")
+	}
+	p.w.WriteString(classType.Name)
+	if classType.Parent != nil {
+		p.w.WriteString(" extends " + classType.Parent.Name)
+	}
+	for i, iface := range classType.Interfaces {
+		if i == 0 {
+			p.w.WriteString(" implements ")
+		} else {
+			p.w.WriteString(", ")
+		}
+		p.w.WriteString(iface.Name)
+	}
+	p.w.WriteString(" {")
+	p.newline()
 	p.depth += 2
-	for _, fieldType := range decl.Type.Fields {
+	for _, c := range classType.Consts {
+		fmt.Fprintf(p.w, "  const %s = ", c.Name)
+		p.enterMember(c.Name, c.Init.(*ir.Node))
+		p.printNode(c.Init.(*ir.Node))
+		p.w.WriteString(";")
+		p.newline()
+	}
+	for _, fieldType := range classType.Fields {
+		if fieldType.Flags.IsPromoted() {
+			continue // Declared by the constructor
+		}
 		fmt.Fprintf(p.w, "  /** @var %s */\n", fieldType.Type.String())
-		fmt.Fprintf(p.w, "  %s $%s", accessModifier(fieldType.Flags), fieldType.Name)
+		p.w.WriteString("  " + memberModifiers(fieldType.Flags) + " ")
+		if hint := typeHint(fieldType.Type); hint != "" && fieldType.Flags.IsTypeHinted() {
+			p.w.WriteString(hint + " ")
+		}
+		p.w.WriteString("$" + fieldType.Name)
 		if fieldType.Init != nil {
 			p.w.WriteString(" = ")
+			p.enterMember(fieldType.Name, fieldType.Init.(*ir.Node))
 			p.printNode(fieldType.Init.(*ir.Node))
 		}
-		p.w.WriteString(";\n")
+		p.w.WriteString(";")
+		p.newline()
 	}
 	for _, method := range decl.Methods {
+		p.enterMember(method.Type.Name, method.Body)
 		p.printFuncDecl(method)
 	}
 	p.depth -= 2
-	p.w.WriteString("}\n")
+	p.w.WriteString("}")
+	p.newline()
 }
 
 func (p *printer) printFuncDecl(decl *ir.RootFuncDecl) {
@@ -146,20 +320,24 @@ func (p *printer) printFuncDecl(decl *ir.RootFuncDecl) {
 
 	p.indent()
 	if decl.Type.Class != nil {
-		p.w.WriteString("public ")
+		if decl.Type.Flags.IsAbstract() && !decl.Type.Class.Flags.IsInterface() {
+			p.w.WriteString("abstract ")
+		}
+		p.w.WriteString(memberModifiers(decl.Type.Flags) + " ")
 	}
 	p.w.WriteString("function " + decl.Type.Name)
-	p.w.WriteByte('(')
-	for i, param := range decl.Type.Params {
-		if i != 0 {
-			p.w.WriteString(", ")
-		}
-		// TODO: print a type hint for some types, sometimes?
-		p.w.WriteString("$" + param.Name)
+	p.printParams(decl.Type.Params)
+	if decl.Type.Flags.IsTypeHinted() {
+		p.printResultHint(decl.Type.Result)
+	}
+	if decl.Body == nil {
+		p.w.WriteString(";")
+		p.newline()
+		return
 	}
-	p.w.WriteString(") ")
+	p.w.WriteByte(' ')
 	p.printNode(decl.Body)
-	p.w.WriteByte('\n')
+	p.newline()
 }
 
 func (p *printer) printSeq(nodes []*ir.Node) {
@@ -170,21 +348,33 @@ func (p *printer) printSeq(nodes []*ir.Node) {
 			p.w.WriteByte(';')
 		}
 		if flags.NeedNewline() {
-			p.w.WriteString("\n")
+			p.newline()
 		}
 	}
 }
 
-//nolint:gocyclo
 func (p *printer) printNode(n *ir.Node) printFlags {
+	if p.config.SourceMap == nil {
+		return p.printNodeOp(n)
+	}
+	start := p.w.Pos()
+	flags := p.printNodeOp(n)
+	p.addSourceMapEntry(n, start)
+	return flags
+}
+
+//nolint:gocyclo
+func (p *printer) printNodeOp(n *ir.Node) printFlags {
 	switch n.Op {
 	case ir.OpBlock:
 		p.depth += 2
-		p.w.WriteString("{\n")
+		p.w.WriteString("{")
+		p.newline()
 		p.printSeq(n.Args)
 		p.depth -= 2
 		p.indent()
-		p.w.WriteString("}\n")
+		p.w.WriteString("}")
+		p.newline()
 		return 0
 
 	case ir.OpEcho:
@@ -214,7 +404,12 @@ func (p *printer) printNode(n *ir.Node) printFlags {
 	case ir.OpBoolLit:
 		fmt.Fprintf(p.w, "%v", n.Value)
 	case ir.OpIntLit:
-		fmt.Fprintf(p.w, "%#v", n.Value)
+		v := n.Value.(int64)
+		if v != math.MinInt64 && p.exoticLiteral() {
+			p.printExoticInt(v)
+		} else {
+			fmt.Fprintf(p.w, "%#v", v)
+		}
 	case ir.OpFloatLit:
 		v := n.Value.(float64)
 		switch {
@@ -226,6 +421,8 @@ func (p *printer) printNode(n *ir.Node) printFlags {
 			p.w.WriteString("make_positive_inf()")
 		case math.IsInf(v, -1):
 			p.w.WriteString("make_negative_inf()")
+		case p.exoticLiteral():
+			p.printExoticFloat(v)
 		default:
 			fmt.Fprintf(p.w, "%#v", n.Value)
 		}
@@ -260,7 +457,7 @@ func (p *printer) printNode(n *ir.Node) printFlags {
 
 	case ir.OpNew:
 		p.w.WriteString("new " + n.Value.(string))
-		p.printArgList(n.Args)
+		p.printArgs(n.Args, true)
 
 	case ir.OpAssign:
 		if varTag, ok := n.Value.(*phpdoc.VarTag); ok {
@@ -376,24 +573,67 @@ func (p *printer) printNode(n *ir.Node) printFlags {
 		p.printNode(n.Args[2])
 
 	case ir.OpArrayLit:
+		open, closing := "array(", ")"
+		if p.chance(0.5) {
+			open, closing = "[", "]"
+		}
 		if len(n.Args) == 0 {
-			p.w.WriteString("array()")
+			p.w.WriteString(open + closing)
 		} else {
-			p.w.WriteString("array(\n")
+			p.w.WriteString(open)
+			p.newline()
 			p.depth += 2
-			for _, elem := range n.Args {
+			for i, elem := range n.Args {
 				p.indent()
 				p.printNode(elem)
-				p.w.WriteString(",\n")
+				if i != len(n.Args)-1 || !p.chance(0.3) {
+					p.w.WriteString(",")
+				}
+				p.newline()
 			}
 			p.depth -= 2
 			p.indent()
-			p.w.WriteString(")")
+			p.w.WriteString(closing)
 		}
 
 	case ir.OpCall:
 		p.printCall(n.Args[0], n.Args[1:])
 
+	case ir.OpClosure:
+		fn := n.Value.(*ir.FuncType)
+		p.w.WriteString("function ")
+		p.printParams(fn.Params)
+		if len(n.Args) > 1 {
+			p.w.WriteString(" use ")
+			p.printArgList(n.Args[1:])
+		}
+		if fn.Flags.IsTypeHinted() {
+			p.printResultHint(fn.Result)
+		}
+		p.w.WriteByte(' ')
+		p.printBraces(n.Args[0])
+
+	case ir.OpArrowFunc:
+		fn := n.Value.(*ir.FuncType)
+		p.w.WriteString("fn")
+		p.printParams(fn.Params)
+		if fn.Flags.IsTypeHinted() {
+			p.printResultHint(fn.Result)
+		}
+		p.w.WriteString(" => ")
+		p.printNode(n.Args[0])
+
+	case ir.OpRef:
+		p.printUnaryPrefix(n, "&")
+
+	case ir.OpStaticMemberAccess:
+		p.printNode(n.Args[0])
+		p.w.WriteString("::" + n.Value.(string))
+
+	case ir.OpInstanceof:
+		p.printNode(n.Args[0])
+		p.w.WriteString(" instanceof " + n.Value.(string))
+
 	case ir.OpCast:
 		p.w.WriteByte('(')
 		p.w.WriteString(n.Type.String())
@@ -403,7 +643,12 @@ func (p *printer) printNode(n *ir.Node) printFlags {
 	case ir.OpSwitch:
 		p.w.WriteString("switch (")
 		p.printNode(n.Args[0])
-		p.w.WriteString(") {\n")
+		if p.config.AlternativeSyntax {
+			p.w.WriteString("):")
+		} else {
+			p.w.WriteString(") {")
+		}
+		p.newline()
 		p.depth += 2
 		for _, c := range n.Args[1:] {
 			var body []*ir.Node
@@ -412,32 +657,139 @@ func (p *printer) printNode(n *ir.Node) printFlags {
 			if c.Op == ir.OpCase {
 				p.w.WriteString("case ")
 				p.printNode(c.Args[0])
-				p.w.WriteString(":\n")
+				p.w.WriteString(":")
+				p.newline()
 				body = c.Args[1:]
 			} else {
 				body = c.Args
-				p.w.WriteString("default:\n")
+				p.w.WriteString("default:")
+				p.newline()
 			}
 			p.printSeq(body)
 			p.depth -= 2
 		}
 		p.depth -= 2
 		p.indent()
-		p.w.WriteString("}\n")
+		if p.config.AlternativeSyntax {
+			p.w.WriteString("endswitch;")
+		} else {
+			p.w.WriteString("}")
+		}
+		p.newline()
+		return 0
+
+	case ir.OpTry:
+		p.w.WriteString("try ")
+		p.printBraces(n.Args[0])
+		for _, clause := range n.Args[1:] {
+			if clause.Op == ir.OpCatch {
+				p.w.WriteString(" catch (")
+				p.w.WriteString(strings.Join(clause.Value.([]string), " | ") + " ")
+				p.printNode(clause.Args[0])
+				p.w.WriteString(") ")
+				p.printBraces(clause.Args[1])
+			} else {
+				p.w.WriteString(" finally ")
+				p.printBraces(clause.Args[0])
+			}
+		}
+		p.newline()
 		return 0
 
+	case ir.OpThrow:
+		p.w.WriteString("throw ")
+		p.printNode(n.Args[0])
+
+	case ir.OpKeyValue:
+		p.printBinary(n, "=>")
+
+	case ir.OpYield:
+		p.w.WriteString("yield ")
+		p.printNode(n.Args[0])
+
+	case ir.OpYieldFrom:
+		p.w.WriteString("yield from ")
+		p.printNode(n.Args[0])
+
+	case ir.OpForeach:
+		p.w.WriteString("foreach (")
+		p.printNode(n.Args[0])
+		p.w.WriteString(" as ")
+		p.printNode(n.Args[1])
+		if p.config.AlternativeSyntax {
+			p.w.WriteByte(')')
+			return p.printAltBlock(n.Args[2], "endforeach")
+		}
+		p.w.WriteString(") ")
+		return p.printNode(n.Args[2])
+
+	case ir.OpUnset:
+		p.printSimpleCall("unset", n.Args)
+
+	case ir.OpIsset:
+		p.printSimpleCall("isset", n.Args)
+
+	case ir.OpList:
+		if n.Value.(bool) {
+			p.w.WriteByte('[')
+		} else {
+			p.w.WriteString("list(")
+		}
+		for i, elem := range n.Args {
+			if i != 0 {
+				p.w.WriteString(", ")
+			}
+			if elem != nil {
+				p.printNode(elem)
+			}
+		}
+		if n.Value.(bool) {
+			p.w.WriteByte(']')
+		} else {
+			p.w.WriteByte(')')
+		}
+
+	case ir.OpEmptyIndex:
+		p.printNode(n.Args[0])
+		p.w.WriteString("[]")
+
 	case ir.OpWhile:
 		p.w.WriteString("while (")
 		p.printNode(n.Args[0])
+		if p.config.AlternativeSyntax {
+			p.w.WriteByte(')')
+			return p.printAltBlock(n.Args[1], "endwhile")
+		}
 		p.w.WriteString(") ")
 		return p.printNode(n.Args[1])
 
 	case ir.OpIf:
 		p.w.WriteString("if (")
 		p.printNode(n.Args[0])
+		if p.config.AlternativeSyntax {
+			p.w.WriteByte(')')
+			return p.printAltBlock(n.Args[1], "endif")
+		}
 		p.w.WriteString(") ")
 		return p.printNode(n.Args[1])
 
+	case ir.OpIfElse:
+		p.w.WriteString("if (")
+		p.printNode(n.Args[0])
+		if p.config.AlternativeSyntax {
+			p.w.WriteString("):")
+			p.printAltSeq(n.Args[1])
+			p.w.WriteString("else:")
+			p.printAltSeq(n.Args[2])
+			p.w.WriteString("endif;")
+			p.newline()
+			return 0
+		}
+		p.w.WriteString(") ")
+		p.printBraces(n.Args[1])
+		p.w.WriteString(" else ")
+		return p.printNode(n.Args[2])
+
 	default:
 		panic(fmt.Sprintf("unexpected %s", n.Op))
 	}
@@ -445,24 +797,102 @@ func (p *printer) printNode(n *ir.Node) printFlags {
 	return flagNeedNewline | flagNeedSemicolon
 }
 
+// printAltBlock prints a block using the alternative syntax: ':' $block... $end ';'
+func (p *printer) printAltBlock(block *ir.Node, end string) printFlags {
+	p.w.WriteByte(':')
+	p.printAltSeq(block)
+	p.w.WriteString(end + ";")
+	p.newline()
+	return 0
+}
+
+// printAltSeq prints the block statements after the alternative syntax ':'
+// and indents the next line for the closing keyword.
+func (p *printer) printAltSeq(block *ir.Node) {
+	p.newline()
+	p.depth += 2
+	p.printSeq(blockStmts(block))
+	p.depth -= 2
+	p.indent()
+}
+
+// printBraces prints a block without a trailing newline.
+func (p *printer) printBraces(block *ir.Node) {
+	p.w.WriteString("{")
+	p.newline()
+	p.depth += 2
+	p.printSeq(blockStmts(block))
+	p.depth -= 2
+	p.indent()
+	p.w.WriteByte('}')
+}
+
+// blockStmts returns the statements of a block,
+// a non-block statement is treated as a block of one.
+func blockStmts(n *ir.Node) []*ir.Node {
+	if n.Op == ir.OpBlock {
+		return n.Args
+	}
+	return []*ir.Node{n}
+}
+
+// printParams prints a parenthesized list of the function or closure params,
+// including the promoted constructor params.
+func (p *printer) printParams(params []ir.TypeField) {
+	p.w.WriteByte('(')
+	for i, param := range params {
+		if i != 0 {
+			p.w.WriteString(", ")
+		}
+		if param.Flags.IsPromoted() {
+			p.w.WriteString(memberModifiers(param.Flags) + " ")
+		}
+		if hint := typeHint(param.Type); hint != "" && param.Flags.IsTypeHinted() {
+			p.w.WriteString(hint + " ")
+		}
+		if param.Flags.IsRef() {
+			p.w.WriteByte('&')
+		}
+		p.w.WriteString("$" + param.Name)
+	}
+	p.w.WriteByte(')')
+}
+
+func (p *printer) printResultHint(typ ir.Type) {
+	if hint := typeHint(typ); hint != "" {
+		p.w.WriteString(": " + hint)
+	}
+}
+
 func (p *printer) printSimpleCall(name string, args []*ir.Node) {
 	p.printCall(ir.NewName(name), args)
 }
 
 func (p *printer) printArgList(args []*ir.Node) {
+	p.printArgs(args, false)
+}
+
+// printArgs prints a parenthesized list of args.
+// A trailing comma is permitted in the call args since PHP 7.3.
+func (p *printer) printArgs(args []*ir.Node, trailingComma bool) {
 	p.w.WriteByte('(')
 	for i, arg := range args {
 		if i != 0 {
-			p.w.WriteString(", ")
+			p.w.WriteByte(',')
+			p.space()
 		}
+		p.optionalSpace()
 		p.printNode(arg)
 	}
+	if trailingComma && len(args) != 0 && p.chance(0.1) {
+		p.w.WriteByte(',')
+	}
 	p.w.WriteByte(')')
 }
 
 func (p *printer) printCall(fn *ir.Node, args []*ir.Node) {
 	p.printNode(fn)
-	p.printArgList(args)
+	p.printArgs(args, true)
 }
 
 func (p *printer) printUnaryPrefix(n *ir.Node, op string) {
@@ -476,9 +906,25 @@ func (p *printer) printUnaryPostfix(n *ir.Node, op string) {
 }
 
 func (p *printer) printBinary(n *ir.Node, op string) {
-	p.printNode(n.Args[0])
-	p.w.WriteString(" " + op + " ")
-	p.printNode(n.Args[1])
+	// The assignment and key-value operands are not always
+	// expressions, so they can't be wrapped into the parens.
+	canWrap := n.Op != ir.OpAssign && n.Op != ir.OpAssignModify && n.Op != ir.OpKeyValue
+	p.printOperand(n.Args[0], canWrap)
+	p.space()
+	p.w.WriteString(op)
+	p.space()
+	p.printOperand(n.Args[1], canWrap)
+}
+
+// printOperand prints x, sometimes wrapped into the redundant parens.
+func (p *printer) printOperand(x *ir.Node, canWrap bool) {
+	if canWrap && p.chance(0.05) {
+		p.w.WriteByte('(')
+		p.printNode(x)
+		p.w.WriteByte(')')
+		return
+	}
+	p.printNode(x)
 }
 
 func (p *printer) printNodes(nodes []*ir.Node, sep string) {
@@ -531,6 +977,16 @@ func (p *printer) getStringBytes(s string) []byte {
 
 func (p *printer) printString(n *ir.Node) {
 	s := n.Value.(string)
+	if p.exoticLiteral() {
+		p.printExoticString(s)
+		return
+	}
+	if canSingleQuote(s) && p.chance(0.3) {
+		p.w.WriteByte('\'')
+		p.w.WriteString(strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s))
+		p.w.WriteByte('\'')
+		return
+	}
 	quote := byte('"')
 	p.w.WriteByte(quote)
 	p.w.Write(p.getStringBytes(s))
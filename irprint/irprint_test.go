@@ -3,6 +3,8 @@ package irprint
 import (
 	"bytes"
 	"fmt"
+	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/quasilyte/phpsmith/ir"
@@ -35,9 +37,93 @@ func TestPrintNodePretty(t *testing.T) {
 		{ir.NewAdd(ir.NewIntLit(1), ir.NewIntLit(2)), `1 + 2`},
 		{ir.NewSub(ir.NewIntLit(1), ir.NewIntLit(2)), `1 - 2`},
 
+		{ir.NewInstanceof(ir.NewVar("x", intType), "Foo"), `$x instanceof Foo`},
+		{ir.NewNullCoalesce(ir.NewVar("x", intType), ir.NewIntLit(1)), `$x ?? 1`},
+		{ir.NewStaticMemberAccess(ir.NewName("Foo"), "$x"), `Foo::$x`},
+		{ir.NewStaticMemberAccess(ir.NewName("self"), "CONST0"), `self::CONST0`},
+
+		{&ir.Node{Op: ir.OpNew, Value: "Foo", Args: []*ir.Node{ir.NewIntLit(1), ir.NewVar("x", intType)}}, `new Foo(1, $x)`},
+
 		{ir.NewReturn(ir.NewVar("x", intType)), "return $x"},
 		{ir.NewReturnVoid(), "return"},
 
+		{
+			ir.NewArrowFunc(&ir.FuncType{Params: []ir.TypeField{{Name: "x", Type: intType, Flags: ir.FlagTypeHinted}}, Result: intType, Flags: ir.FlagTypeHinted}, ir.NewVar("x", intType)),
+			`fn(int $x): int => $x`,
+		},
+		{
+			ir.NewArrowFunc(&ir.FuncType{Params: []ir.TypeField{{Name: "x", Type: intType}}, Result: intType}, ir.NewVar("x", intType)),
+			`fn($x) => $x`,
+		},
+		{
+			ir.NewClosure(&ir.FuncType{Result: intType, Flags: ir.FlagTypeHinted}, ir.NewBlock(ir.NewReturn(ir.NewVar("x", intType))),
+				ir.NewVar("x", intType), ir.NewRef(ir.NewVar("y", intType))),
+			`function () use ($x, &$y): int {
+  return $x;
+}`,
+		},
+
+		{ir.NewThrow(ir.NewVar("e", intType)), `throw $e`},
+		{
+			ir.NewTry(ir.NewBlock(ir.NewEcho(ir.NewIntLit(1))),
+				ir.NewCatch([]string{"A", "B"}, ir.NewVar("e", intType), ir.NewBlock()),
+				ir.NewFinally(ir.NewBlock(ir.NewEcho(ir.NewIntLit(2))))),
+			`try {
+  echo 1;
+} catch (A | B $e) {
+} finally {
+  echo 2;
+}
+`,
+		},
+
+		{ir.NewYield(ir.NewIntLit(1)), `yield 1`},
+		{ir.NewYield(ir.NewKeyValue(ir.NewStringLit("k"), ir.NewIntLit(1))), `yield "k" => 1`},
+		{ir.NewAssign(ir.NewVar("x", intType), ir.NewYieldFrom(ir.NewCall(ir.NewName("gen")))), `$x = yield from gen()`},
+		{
+			ir.NewForeach(ir.NewVar("xs", intType), ir.NewKeyValue(ir.NewVar("k", intType), ir.NewVar("v", intType)),
+				ir.NewBlock(ir.NewEcho(ir.NewVar("v", intType)))),
+			`foreach ($xs as $k => $v) {
+  echo $v;
+}
+`,
+		},
+
+		{ir.NewAssign(ir.NewVar("r", intType), ir.NewRef(ir.NewIndex(ir.NewVar("xs", intType), ir.NewIntLit(0)))), `$r = &$xs[0]`},
+		{ir.NewUnset(ir.NewVar("x", intType), ir.NewVar("y", intType)), `unset($x, $y)`},
+		{
+			ir.NewForeach(ir.NewVar("xs", intType), ir.NewRef(ir.NewVar("v", intType)),
+				ir.NewBlock(ir.NewAssign(ir.NewVar("v", intType), ir.NewIntLit(1)))),
+			`foreach ($xs as &$v) {
+  $v = 1;
+}
+`,
+		},
+		{ir.NewAssign(ir.NewEmptyIndex(ir.NewVar("xs", intType)), ir.NewIntLit(1)), `$xs[] = 1`},
+		{ir.NewIsset(ir.NewIndex(ir.NewVar("xs", intType), ir.NewStringLit("k"))), `isset($xs["k"])`},
+		{
+			&ir.Node{Op: ir.OpArrayLit, Args: []*ir.Node{
+				ir.NewKeyValue(ir.NewStringLit("1"), ir.NewIntLit(1)),
+				ir.NewKeyValue(ir.NewIntLit(1), ir.NewIntLit(2)),
+			}},
+			`array(
+  "1" => 1,
+  1 => 2,
+)`,
+		},
+		{
+			ir.NewAssign(ir.NewList(false, ir.NewVar("a", intType), nil, ir.NewList(false, ir.NewVar("b", intType))), ir.NewVar("t", intType)),
+			`list($a, , list($b)) = $t`,
+		},
+		{
+			ir.NewAssign(ir.NewList(true, ir.NewKeyValue(ir.NewStringLit("k"), ir.NewVar("v", intType))), ir.NewVar("xs", intType)),
+			`["k" => $v] = $xs`,
+		},
+		{
+			ir.NewArrowFunc(&ir.FuncType{Params: []ir.TypeField{{Name: "x", Type: intType, Flags: ir.FlagRef | ir.FlagTypeHinted}}, Result: intType, Flags: ir.FlagTypeHinted}, ir.NewVar("x", intType)),
+			`fn(int &$x): int => $x`,
+		},
+
 		{
 			ir.NewBlock(ir.NewEcho(ir.NewStringLit("ok"))),
 			`{
@@ -60,3 +146,277 @@ func TestPrintNodePretty(t *testing.T) {
 		})
 	}
 }
+
+func TestPrintClassDecl(t *testing.T) {
+	intType := &ir.ScalarType{Kind: ir.ScalarInt}
+
+	class := &ir.ClassType{Name: "Foo"}
+	class.Fields = []ir.TypeField{
+		{Name: "x", Type: intType, Flags: ir.FlagPrivate | ir.FlagPromoted},
+		{Name: "y", Type: intType, Flags: ir.FlagPublic},
+	}
+	class.Constructor = &ir.FuncType{
+		Name:  "__construct",
+		Flags: ir.FlagPublic,
+		Class: class,
+		Params: []ir.TypeField{
+			{Name: "x", Type: intType, Flags: ir.FlagPrivate | ir.FlagPromoted},
+			{Name: "y", Type: intType},
+		},
+	}
+	this := ir.NewVar("this", class)
+	decl := &ir.RootClassDecl{
+		Type: class,
+		Methods: []*ir.RootFuncDecl{
+			{
+				Type: class.Constructor,
+				Body: ir.NewBlock(ir.NewAssign(ir.NewMemberAccess(this, "y"), ir.NewVar("y", intType))),
+			},
+		},
+	}
+
+	want := `class Foo {
+  /** @var int */
+  public $y;
+  public function __construct(private $x, $y) {
+    $this->y = $y;
+  }
+
+}
+`
+	var buf bytes.Buffer
+	FprintRootNode(&buf, decl, &Config{})
+	if have := buf.String(); have != want {
+		t.Fatalf("print class decl:\nhave: %q\nwant: %q", have, want)
+	}
+}
+
+func TestPrintTypeHints(t *testing.T) {
+	intType := &ir.ScalarType{Kind: ir.ScalarInt}
+
+	fn := &ir.FuncType{
+		Name:  "f",
+		Flags: ir.FlagTypeHinted,
+		Params: []ir.TypeField{
+			{Name: "x", Type: intType, Flags: ir.FlagTypeHinted | ir.FlagRef},
+			{Name: "y", Type: &ir.NullableType{X: intType}, Flags: ir.FlagTypeHinted},
+			{Name: "z", Type: intType},
+		},
+		Result: &ir.ArrayType{Elem: intType},
+	}
+	nodes := []ir.RootNode{
+		&ir.RootDeclare{Directive: "strict_types", Value: ir.NewIntLit(1)},
+		&ir.RootFuncDecl{Type: fn, Body: ir.NewBlock()},
+	}
+
+	want := `declare(strict_types=1);
+function f(int &$x, ?int $y, $z): array {
+}
+
+`
+	var buf bytes.Buffer
+	for _, n := range nodes {
+		FprintRootNode(&buf, n, &Config{})
+	}
+	if have := buf.String(); have != want {
+		t.Fatalf("print type hints:\nhave: %q\nwant: %q", have, want)
+	}
+}
+
+func TestPrintRandomized(t *testing.T) {
+	intType := &ir.ScalarType{Kind: ir.ScalarInt}
+
+	n := ir.NewBlock(
+		ir.NewAssign(ir.NewVar("x", intType), &ir.Node{Op: ir.OpArrayLit, Args: []*ir.Node{
+			ir.NewAdd(ir.NewIntLit(1), ir.NewIntLit(2)),
+			ir.NewStringLit("it's"),
+		}}),
+		ir.NewEcho(ir.NewCall(ir.NewName("f"), ir.NewVar("x", intType), ir.NewIntLit(3))),
+	)
+	print := func(seed int64) string {
+		var buf bytes.Buffer
+		FprintNode(&buf, n, &Config{Rand: rand.New(rand.NewSource(seed))})
+		return buf.String()
+	}
+
+	outputs := make(map[string]struct{})
+	for seed := int64(0); seed < 50; seed++ {
+		have := print(seed)
+		if have2 := print(seed); have != have2 {
+			t.Fatalf("seed %d: output is not reproducible:\n%q\n%q", seed, have, have2)
+		}
+		outputs[have] = struct{}{}
+	}
+	if len(outputs) < 10 {
+		t.Fatalf("too few distinct outputs: %d", len(outputs))
+	}
+}
+
+func TestPrintSingleQuotedString(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{`a\`, `'a\\'`},
+		{`\'`, `'\\\''`},
+		{`\\`, `'\\\\'`},
+		{`it's`, `'it\'s'`},
+	}
+
+	for _, test := range tests {
+		// The single quotes are picked at random.
+		found := false
+		for seed := int64(0); seed < 100 && !found; seed++ {
+			var buf bytes.Buffer
+			FprintNode(&buf, ir.NewStringLit(test.s), &Config{Rand: rand.New(rand.NewSource(seed))})
+			have := buf.String()
+			if !strings.HasPrefix(have, "'") {
+				continue
+			}
+			found = true
+			if have != test.want {
+				t.Errorf("print %q:\nhave: %s\nwant: %s", test.s, have, test.want)
+			}
+		}
+		if !found {
+			t.Errorf("print %q: no single-quoted output", test.s)
+		}
+	}
+}
+
+func TestPrintModes(t *testing.T) {
+	intType := &ir.ScalarType{Kind: ir.ScalarInt}
+
+	tests := []struct {
+		n      *ir.Node
+		config Config
+		want   string
+	}{
+		{ir.NewIntLit(255), Config{ExoticLiterals: true}, `0xff`},
+		{ir.NewIntLit(-16), Config{ExoticLiterals: true}, `-0x10`},
+		{ir.NewFloatLit(2.5), Config{ExoticLiterals: true}, `2.5e+00`},
+		{ir.NewStringLit("aハ"), Config{ExoticLiterals: true}, `"\x61\u{30cf}"`},
+		{
+			ir.NewIf(ir.NewVar("x", intType), ir.NewBlock(ir.NewEcho(ir.NewIntLit(1)))),
+			Config{AlternativeSyntax: true},
+			"if ($x):\n  echo 1;\nendif;\n",
+		},
+		{
+			ir.NewIfElse(ir.NewVar("x", intType), ir.NewBlock(ir.NewEcho(ir.NewIntLit(1))), ir.NewBlock(ir.NewEcho(ir.NewIntLit(2)))),
+			Config{AlternativeSyntax: true},
+			"if ($x):\n  echo 1;\nelse:\n  echo 2;\nendif;\n",
+		},
+		{
+			ir.NewIfElse(ir.NewVar("x", intType), ir.NewBlock(ir.NewEcho(ir.NewIntLit(1))), ir.NewBlock(ir.NewEcho(ir.NewIntLit(2)))),
+			Config{},
+			"if ($x) {\n  echo 1;\n} else {\n  echo 2;\n}\n",
+		},
+		{
+			ir.NewWhile(ir.NewVar("x", intType), ir.NewBlock()),
+			Config{AlternativeSyntax: true},
+			"while ($x):\nendwhile;\n",
+		},
+		{
+			ir.NewForeach(ir.NewVar("xs", intType), ir.NewVar("x", intType), ir.NewBlock()),
+			Config{AlternativeSyntax: true},
+			"foreach ($xs as $x):\nendforeach;\n",
+		},
+		{
+			&ir.Node{Op: ir.OpSwitch, Args: []*ir.Node{
+				ir.NewVar("x", intType),
+				{Op: ir.OpDefaultCase, Args: []*ir.Node{ir.NewBreak(0)}},
+			}},
+			Config{AlternativeSyntax: true},
+			"switch ($x):\n  default:\n    break;\nendswitch;\n",
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(fmt.Sprintf("test%d", i), func(t *testing.T) {
+			var buf bytes.Buffer
+			FprintNode(&buf, test.n, &test.config)
+			if have := buf.String(); have != test.want {
+				t.Fatalf("print %s:\nhave: %q\nwant: %q", test.n.Op, have, test.want)
+			}
+		})
+	}
+}
+
+func TestPrintIfElse(t *testing.T) {
+	intType := &ir.ScalarType{Kind: ir.ScalarInt}
+	x := ir.NewVar("x", intType)
+	echo := func(v int64) *ir.Node { return ir.NewBlock(ir.NewEcho(ir.NewIntLit(v))) }
+	// if ($x) 1 else if ($x) 2 else 3
+	chain := ir.NewIfElse(x, echo(1), ir.NewIfElse(x, echo(2), echo(3)))
+
+	tests := []struct {
+		config Config
+		want   string
+	}{
+		{
+			Config{},
+			"if ($x) {\n  echo 1;\n} else if ($x) {\n  echo 2;\n} else {\n  echo 3;\n}\n",
+		},
+		{
+			Config{AlternativeSyntax: true},
+			"if ($x):\n  echo 1;\nelse:\n  if ($x):\n    echo 2;\n  else:\n    echo 3;\n  endif;\nendif;\n",
+		},
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		FprintNode(&buf, chain, &test.config)
+		if have := buf.String(); have != test.want {
+			t.Errorf("print if-else chain (alternative=%v):\nhave: %q\nwant: %q", test.config.AlternativeSyntax, have, test.want)
+		}
+	}
+}
+
+func TestPrintSourceMap(t *testing.T) {
+	intType := &ir.ScalarType{Kind: ir.ScalarInt}
+
+	fn := &ir.FuncType{Name: "f"}
+	sum := ir.NewAdd(ir.NewVar("x", intType), ir.NewIntLit(10))
+	nodes := []ir.RootNode{
+		&ir.RootRequire{Path: "lib.php"},
+		&ir.RootFuncDecl{Type: fn, Body: ir.NewBlock(ir.NewEcho(sum))},
+	}
+
+	sourceMap := &SourceMap{}
+	var buf bytes.Buffer
+	FprintFile(&buf, "main.php", nodes, &Config{SourceMap: sourceMap})
+
+	wantCode := "<?php\nrequire_once __DIR__ . '/lib.php';\nfunction f() {\n  echo $x + 10;\n}\n\n"
+	if have := buf.String(); have != wantCode {
+		t.Fatalf("print file:\nhave: %q\nwant: %q", have, wantCode)
+	}
+
+	type entry struct {
+		root       int
+		path       string
+		op         string
+		start, end SourcePos
+	}
+	want := []entry{
+		{0, "[]", "RootRequire", SourcePos{2, 1}, SourcePos{3, 1}},
+		{1, "[0 0 0]", "Var", SourcePos{4, 8}, SourcePos{4, 10}},
+		{1, "[0 0 1]", "IntLit", SourcePos{4, 13}, SourcePos{4, 15}},
+		{1, "[0 0]", "Add", SourcePos{4, 8}, SourcePos{4, 15}},
+		{1, "[0]", "Echo", SourcePos{4, 3}, SourcePos{4, 15}},
+		{1, "[]", "Block", SourcePos{3, 14}, SourcePos{6, 1}},
+		{1, "[]", "RootFuncDecl", SourcePos{3, 1}, SourcePos{7, 1}},
+	}
+	if len(sourceMap.Entries) != len(want) {
+		t.Fatalf("have %d source map entries, want %d", len(sourceMap.Entries), len(want))
+	}
+	for i, e := range sourceMap.Entries {
+		have := entry{e.Root, fmt.Sprint(e.Path), e.Op, e.Start, e.End}
+		if e.File != "main.php" {
+			t.Errorf("entry%d: file is %q", i, e.File)
+		}
+		if have != want[i] {
+			t.Errorf("entry%d:\nhave: %+v\nwant: %+v", i, have, want[i])
+		}
+	}
+}
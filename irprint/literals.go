@@ -0,0 +1,144 @@
+package irprint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/quasilyte/phpsmith/randutil"
+)
+
+// exoticLiteral reports whether the next literal should be printed in its exotic form.
+func (p *printer) exoticLiteral() bool {
+	if !p.config.ExoticLiterals {
+		return false
+	}
+	return p.config.Rand == nil || randutil.Chance(p.config.Rand, 0.3)
+}
+
+// printExoticInt prints v as a hex, octal or binary literal,
+// the digits can be grouped with the separators (PHP 7.4+).
+func (p *printer) printExoticInt(v int64) {
+	if v < 0 {
+		p.w.WriteByte('-')
+		v = -v
+	}
+	r := p.config.Rand
+	if r == nil {
+		fmt.Fprintf(p.w, "0x%x", v)
+		return
+	}
+
+	var prefix, digits string
+	switch r.Intn(4) {
+	case 0:
+		prefix = "0x"
+		digits = strconv.FormatInt(v, 16)
+		if randutil.Bool(r) {
+			prefix = "0X"
+			digits = strings.ToUpper(digits)
+		}
+	case 1:
+		prefix = "0"
+		digits = strconv.FormatInt(v, 8)
+	case 2:
+		prefix = "0b"
+		digits = strconv.FormatInt(v, 2)
+	default:
+		digits = strconv.FormatInt(v, 10)
+	}
+	if prefix == "0" && v == 0 {
+		prefix = ""
+	}
+
+	p.w.WriteString(prefix)
+	for i := 0; i < len(digits); i++ {
+		if i != 0 && randutil.Chance(r, 0.15) {
+			p.w.WriteByte('_')
+		}
+		p.w.WriteByte(digits[i])
+	}
+}
+
+// printExoticFloat prints v in exponent form or without a leading zero.
+func (p *printer) printExoticFloat(v float64) {
+	if p.config.Rand != nil && v > -1 && v < 1 && randutil.Bool(p.config.Rand) {
+		s := strconv.FormatFloat(v, 'f', -1, 64)
+		p.w.WriteString(strings.Replace(s, "0.", ".", 1))
+		return
+	}
+	p.w.WriteString(strconv.FormatFloat(v, 'e', -1, 64))
+}
+
+// printExoticString prints s as a heredoc, a nowdoc or a double-quoted
+// string with the hex and unicode codepoint escapes.
+func (p *printer) printExoticString(s string) {
+	r := p.config.Rand
+	const delimiter = "EOT"
+	if r != nil && s != "" && !strings.Contains(s, delimiter) {
+		switch r.Intn(3) {
+		case 0:
+			p.printHeredoc(s, delimiter)
+			return
+		case 1:
+			if canNowdoc(s) {
+				p.w.WriteString("<<<'" + delimiter + "'\n" + s + "\n" + delimiter)
+				return
+			}
+		}
+	}
+
+	p.w.WriteByte('"')
+	for len(s) != 0 {
+		ch, size := utf8.DecodeRuneInString(s)
+		switch {
+		case size > 1 && ch != utf8.RuneError && (r == nil || randutil.Bool(r)):
+			fmt.Fprintf(p.w, `\u{%x}`, ch)
+		case r == nil || randutil.Chance(r, 0.3):
+			for i := 0; i < size; i++ {
+				fmt.Fprintf(p.w, `\x%02x`, s[i])
+			}
+		case s[0] == '$':
+			p.w.WriteString(`\$`)
+		default:
+			p.w.Write(p.getStringBytes(s[:size]))
+		}
+		s = s[size:]
+	}
+	p.w.WriteByte('"')
+}
+
+// printHeredoc prints s as a heredoc with the closing delimiter at the line start,
+// so no indentation is removed from the heredoc body (PHP 7.3+).
+func (p *printer) printHeredoc(s, delimiter string) {
+	p.w.WriteString("<<<" + delimiter + "\n")
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case ch == '\\':
+			p.w.WriteString(`\\`)
+		case ch == '$':
+			p.w.WriteString(`\$`)
+		case ch == '\n':
+			p.w.WriteByte('\n')
+		case ch == '\t':
+			p.w.WriteString(`\t`)
+		case ch < 32:
+			fmt.Fprintf(p.w, `\%03o`, ch)
+		default:
+			p.w.WriteByte(ch)
+		}
+	}
+	p.w.WriteString("\n" + delimiter)
+}
+
+// canNowdoc reports whether s can be printed as a nowdoc without changing its bytes.
+func canNowdoc(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 32 && s[i] != '\n' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,163 @@
+package irprint
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/quasilyte/phpsmith/ir"
+)
+
+// SourceMap maps the printed code ranges to the IR nodes they were printed from.
+type SourceMap struct {
+	Entries []SourceMapEntry `json:"entries"`
+}
+
+// SourceMapEntry describes a single printed IR node or root node.
+//
+// A node is identified by its file, the root node index inside that file,
+// the class member name (for the class declarations) and the path
+// of Args indexes from the member top node. The top node is a function body,
+// a field or const initializer, or a root statement.
+//
+// Root node entries have a nil Path and their Op is a root node kind,
+// like "RootFuncDecl" or "RootClassDecl".
+type SourceMapEntry struct {
+	File   string `json:"file"`
+	Root   int    `json:"root"`
+	Member string `json:"member,omitempty"`
+	Path   []int  `json:"path"`
+	Op     string `json:"op"`
+
+	Start SourcePos `json:"start"`
+	End   SourcePos `json:"end"`
+
+	Node     *ir.Node    `json:"-"`
+	RootNode ir.RootNode `json:"-"`
+}
+
+// SourcePos is a 1-based line and column pair.
+// The column is measured in bytes.
+type SourcePos struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// sourceMapState holds the information about the IR subtree being printed.
+type sourceMapState struct {
+	file   string
+	root   int
+	member string
+	paths  map[*ir.Node][]int
+}
+
+// enterMember starts a new top node for the source map entries.
+func (p *printer) enterMember(name string, top *ir.Node) {
+	if p.config.SourceMap == nil {
+		return
+	}
+	p.sourceMap.member = name
+	p.sourceMap.paths = make(map[*ir.Node][]int)
+	if top != nil {
+		collectPaths(p.sourceMap.paths, top)
+	}
+}
+
+func (p *printer) addSourceMapEntry(n *ir.Node, start SourcePos) {
+	path, ok := p.sourceMap.paths[n]
+	if !ok {
+		return // A helper node that is not a part of the IR tree
+	}
+	p.config.SourceMap.Entries = append(p.config.SourceMap.Entries, SourceMapEntry{
+		File:   p.sourceMap.file,
+		Root:   p.sourceMap.root,
+		Member: p.sourceMap.member,
+		Path:   path,
+		Op:     n.Op.String(),
+		Start:  start,
+		End:    p.w.Pos(),
+		Node:   n,
+	})
+}
+
+func (p *printer) addRootSourceMapEntry(n ir.RootNode, start SourcePos) {
+	var kind string
+	switch n.(type) {
+	case *ir.RootFuncDecl:
+		kind = "RootFuncDecl"
+	case *ir.RootClassDecl:
+		kind = "RootClassDecl"
+	case *ir.RootStmt:
+		kind = "RootStmt"
+	case *ir.RootDeclare:
+		kind = "RootDeclare"
+	case *ir.RootRequire:
+		kind = "RootRequire"
+	}
+	p.config.SourceMap.Entries = append(p.config.SourceMap.Entries, SourceMapEntry{
+		File:     p.sourceMap.file,
+		Root:     p.sourceMap.root,
+		Op:       kind,
+		Start:    start,
+		End:      p.w.Pos(),
+		RootNode: n,
+	})
+}
+
+// collectPaths records the Args paths of n and its children.
+// If a node is shared, the first path is used.
+func collectPaths(paths map[*ir.Node][]int, n *ir.Node) {
+	ir.WalkNode(n, func(n *ir.Node, c *ir.Cursor) bool {
+		if _, ok := paths[n]; ok {
+			return false
+		}
+		paths[n] = append([]int{}, c.Path...)
+		return true
+	})
+}
+
+// positionWriter is a buffered writer that tracks the current output position.
+type positionWriter struct {
+	w      *bufio.Writer
+	line   int
+	column int
+}
+
+func newPositionWriter(w io.Writer) *positionWriter {
+	return &positionWriter{w: bufio.NewWriter(w), line: 1, column: 1}
+}
+
+func (w *positionWriter) Pos() SourcePos {
+	return SourcePos{Line: w.line, Column: w.column}
+}
+
+func (w *positionWriter) advance(ch byte) {
+	if ch == '\n' {
+		w.line++
+		w.column = 1
+	} else {
+		w.column++
+	}
+}
+
+func (w *positionWriter) Write(b []byte) (int, error) {
+	for _, ch := range b {
+		w.advance(ch)
+	}
+	return w.w.Write(b)
+}
+
+func (w *positionWriter) WriteString(s string) (int, error) {
+	for i := 0; i < len(s); i++ {
+		w.advance(s[i])
+	}
+	return w.w.WriteString(s)
+}
+
+func (w *positionWriter) WriteByte(ch byte) error {
+	w.advance(ch)
+	return w.w.WriteByte(ch)
+}
+
+func (w *positionWriter) Flush() error {
+	return w.w.Flush()
+}
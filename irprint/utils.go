@@ -2,6 +2,13 @@ package irprint
 
 import "github.com/quasilyte/phpsmith/ir"
 
+func memberModifiers(flags ir.TypeFlags) string {
+	if flags.IsStatic() {
+		return accessModifier(flags) + " static"
+	}
+	return accessModifier(flags)
+}
+
 func accessModifier(flags ir.TypeFlags) string {
 	switch {
 	case flags.IsPrivate():
@@ -12,3 +19,38 @@ func accessModifier(flags ir.TypeFlags) string {
 		return "public"
 	}
 }
+
+// typeHint returns a native PHP type hint for the given type.
+// An empty string is returned for the types that can't be expressed as type hints.
+func typeHint(typ ir.Type) string {
+	switch typ := typ.(type) {
+	case *ir.ScalarType:
+		switch typ.Kind {
+		case ir.ScalarBool, ir.ScalarInt, ir.ScalarFloat, ir.ScalarString:
+			return typ.String()
+		}
+	case *ir.EnumType:
+		return typeHint(typ.ValueType)
+	case *ir.ArrayType:
+		return "array"
+	case *ir.ClassType:
+		// Class-typed values can be null.
+		return "?" + typ.Name
+	case *ir.NullableType:
+		if hint := typeHint(typ.X); hint != "" && hint[0] != '?' {
+			return "?" + hint
+		}
+	}
+	return ""
+}
+
+// canSingleQuote reports whether s can be printed as a single-quoted string literal.
+// The control chars are only printed as escapes that are not supported by the single quotes.
+func canSingleQuote(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 32 {
+			return false
+		}
+	}
+	return true
+}
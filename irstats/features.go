@@ -0,0 +1,86 @@
+package irstats
+
+import (
+	"strings"
+
+	"github.com/quasilyte/phpsmith/ir"
+)
+
+// Features counts the generator features present in the program IR.
+//
+// The feature keys are:
+//
+//	op:<Op>               a node op, like "op:Cast"
+//	pair:<Op>><Op>        an expression inside another expression, like "pair:Ternary>Cast"
+//	type:<Op>:<shape>     a node type shape, like "type:Var:array<int>"
+//	builtin:<name>        a phpfunc builtin call, like "builtin:strlen"
+//
+// The parentheses are skipped in the pairs.
+func Features(files []*ir.File) map[string]int {
+	features := make(map[string]int)
+	for _, f := range files {
+		for _, root := range f.Nodes {
+			ir.Walk(root, func(n *ir.Node, c *ir.Cursor) bool {
+				features["op:"+n.Op.String()]++
+				if n.Type != nil {
+					features["type:"+n.Op.String()+":"+typeShape(n.Type, 2)]++
+				}
+				if n.IsExpression() && n.Op != ir.OpParens {
+					for _, arg := range n.Args {
+						for arg != nil && arg.Op == ir.OpParens {
+							arg = arg.Args[0]
+						}
+						if arg != nil && arg.IsExpression() {
+							features["pair:"+n.Op.String()+">"+arg.Op.String()]++
+						}
+					}
+				}
+				if n.Op == ir.OpCall && n.Args[0].Op == ir.OpName {
+					if name := n.Args[0].Value.(string); builtinFuncs[name] {
+						features["builtin:"+name]++
+					}
+				}
+				return true
+			})
+		}
+	}
+	return features
+}
+
+// FeatureOps returns the ops the feature is about.
+func FeatureOps(feature string) []string {
+	kind, key, _ := strings.Cut(feature, ":")
+	switch kind {
+	case "op":
+		return []string{key}
+	case "pair":
+		parent, child, _ := strings.Cut(key, ">")
+		return []string{parent, child}
+	case "type":
+		op, _, _ := strings.Cut(key, ":")
+		return []string{op}
+	case "builtin":
+		return []string{ir.OpCall.String()}
+	default:
+		return nil
+	}
+}
+
+// typeShape describes the type structure up to the given depth.
+func typeShape(typ ir.Type, depth int) string {
+	if depth == 0 {
+		return typeTagName(typ.Tag())
+	}
+	switch typ := typ.(type) {
+	case *ir.ScalarType:
+		return typ.Kind.String()
+	case *ir.ArrayType:
+		return "array<" + typeShape(typ.Elem, depth-1) + ">"
+	case *ir.NullableType:
+		return "?" + typeShape(typ.X, depth-1)
+	case *ir.UnionType:
+		return typeShape(typ.X, depth-1) + "|" + typeShape(typ.Y, depth-1)
+	default:
+		return typeTagName(typ.Tag())
+	}
+}
@@ -0,0 +1,205 @@
+// Package irstats collects the generated programs feature statistics.
+//
+// It shows what the generator actually produces: which ops, types
+// and builtin functions are used and how big the programs are.
+package irstats
+
+import (
+	"bytes"
+	"math/bits"
+	"strconv"
+
+	"github.com/quasilyte/phpsmith/ir"
+	"github.com/quasilyte/phpsmith/phpfunc"
+)
+
+// Stats is a set of histograms collected over the added programs.
+type Stats struct {
+	Programs int `json:"programs"`
+
+	// Ops counts every node op.
+	// All ops are listed, so the ones that are never generated have 0 count.
+	Ops Histogram `json:"ops"`
+
+	// Types counts the node types by their Tag().
+	Types Histogram `json:"types"`
+
+	// Builtins counts the phpfunc builtin calls.
+	// All builtins are listed, like the Ops.
+	Builtins Histogram `json:"builtins"`
+
+	// Statements counts the statement ops.
+	Statements Histogram `json:"statements"`
+
+	// ExprDepth is a depth of the expression trees that are not a part
+	// of another expression, like the statement arguments and initializers.
+	ExprDepth *Distribution `json:"expr_depth"`
+
+	// ProgramSize is a printed program size in bytes.
+	ProgramSize *Distribution `json:"program_size"`
+
+	// FileLines is a number of lines in every printed file.
+	FileLines *Distribution `json:"file_lines"`
+}
+
+// Histogram maps a feature name to its number of occurrences.
+type Histogram map[string]int
+
+// Distribution describes the collected numeric values.
+type Distribution struct {
+	Count int     `json:"count"`
+	Min   int     `json:"min"`
+	Max   int     `json:"max"`
+	Mean  float64 `json:"mean"`
+
+	// Buckets split the values by the powers of two,
+	// the empty buckets are included.
+	Buckets []Bucket `json:"buckets"`
+
+	sum int
+}
+
+// Bucket counts the values in the [Min, Max] range.
+type Bucket struct {
+	Min   int `json:"min"`
+	Max   int `json:"max"`
+	Count int `json:"count"`
+}
+
+var builtinFuncs = func() map[string]bool {
+	funcs := make(map[string]bool)
+	for _, f := range phpfunc.GetList() {
+		funcs[f.Name] = true
+	}
+	return funcs
+}()
+
+// File is a printed program file.
+type File struct {
+	Name     string
+	Contents []byte
+}
+
+func New() *Stats {
+	s := &Stats{
+		Ops:         Histogram{},
+		Types:       Histogram{},
+		Builtins:    Histogram{},
+		Statements:  Histogram{},
+		ExprDepth:   &Distribution{},
+		ProgramSize: &Distribution{},
+		FileLines:   &Distribution{},
+	}
+	// The stringer output is the only list of ops we have.
+	for op := ir.OpInvalid + 1; op.String() != "Op("+strconv.Itoa(int(op))+")"; op++ {
+		s.Ops[op.String()] = 0
+	}
+	for name := range builtinFuncs {
+		s.Builtins[name] = 0
+	}
+	return s
+}
+
+// Add collects the stats of a program, given its IR and the printed files.
+// The printed files should not include the runtime support files.
+func (s *Stats) Add(files []*ir.File, printed []File) {
+	s.Programs++
+
+	for _, f := range files {
+		for _, root := range f.Nodes {
+			ir.Walk(root, func(n *ir.Node, c *ir.Cursor) bool {
+				s.addNode(n, c)
+				return true
+			})
+		}
+	}
+
+	size := 0
+	for _, f := range printed {
+		size += len(f.Contents)
+		s.FileLines.Add(bytes.Count(f.Contents, []byte("\n")))
+	}
+	s.ProgramSize.Add(size)
+}
+
+func (s *Stats) addNode(n *ir.Node, c *ir.Cursor) {
+	s.Ops[n.Op.String()]++
+	if n.Type != nil {
+		s.Types[typeTagName(n.Type.Tag())]++
+	}
+	if n.IsStatement() {
+		s.Statements[n.Op.String()]++
+	}
+	if n.IsExpression() && (c.Parent == nil || !c.Parent.IsExpression()) {
+		s.ExprDepth.Add(exprDepth(n))
+	}
+	if n.Op == ir.OpCall && n.Args[0].Op == ir.OpName {
+		if name := n.Args[0].Value.(string); builtinFuncs[name] {
+			s.Builtins[name]++
+		}
+	}
+}
+
+// exprDepth returns the expression tree depth.
+// The statements inside the closures are not counted.
+func exprDepth(n *ir.Node) int {
+	depth := 0
+	for _, arg := range n.Args {
+		if arg == nil || arg.IsStatement() {
+			continue
+		}
+		if d := exprDepth(arg); d > depth {
+			depth = d
+		}
+	}
+	return depth + 1
+}
+
+// Add records a value.
+func (d *Distribution) Add(v int) {
+	if d.Count == 0 || v < d.Min {
+		d.Min = v
+	}
+	if d.Count == 0 || v > d.Max {
+		d.Max = v
+	}
+	d.Count++
+	d.sum += v
+	d.Mean = float64(d.sum) / float64(d.Count)
+
+	i := bits.Len(uint(v))
+	for len(d.Buckets) <= i {
+		k := len(d.Buckets)
+		b := Bucket{Min: 0, Max: 0}
+		if k != 0 {
+			b = Bucket{Min: 1 << (k - 1), Max: 1<<k - 1}
+		}
+		d.Buckets = append(d.Buckets, b)
+	}
+	d.Buckets[i].Count++
+}
+
+func typeTagName(tag int) string {
+	switch tag {
+	case ir.TypeTagScalar:
+		return "scalar"
+	case ir.TypeTagClass:
+		return "class"
+	case ir.TypeTagUnion:
+		return "union"
+	case ir.TypeTagNullable:
+		return "nullable"
+	case ir.TypeTagArray:
+		return "array"
+	case ir.TypeTagTuple:
+		return "tuple"
+	case ir.TypeTagFunc:
+		return "func"
+	case ir.TypeTagEnum:
+		return "enum"
+	case ir.TypeTagGenerator:
+		return "generator"
+	default:
+		return "tag" + strconv.Itoa(tag)
+	}
+}
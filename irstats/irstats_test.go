@@ -0,0 +1,99 @@
+package irstats
+
+import (
+	"testing"
+
+	"github.com/quasilyte/phpsmith/ir"
+)
+
+func TestStats(t *testing.T) {
+	intType := &ir.ScalarType{Kind: ir.ScalarInt}
+	f := &ir.RootFuncDecl{
+		Type: &ir.FuncType{Name: "f", Result: ir.VoidType},
+		Body: ir.NewBlock(
+			ir.NewAssign(ir.NewVar("x", intType), ir.NewAdd(ir.NewIntLit(1), ir.NewCall(ir.NewName("strlen"), ir.NewStringLit("a")))),
+			ir.NewReturnVoid(),
+		),
+	}
+
+	s := New()
+	s.Add([]*ir.File{{Name: "main.php", Nodes: []ir.RootNode{f}}}, []File{
+		{Name: "main.php", Contents: []byte("<?php\nfunction f() {\n}\n")},
+	})
+
+	counts := []struct {
+		h    Histogram
+		name string
+		want int
+	}{
+		{s.Ops, "Add", 1},
+		{s.Ops, "Call", 1},
+		{s.Ops, "Spaceship", 0},
+		{s.Types, "scalar", 1},
+		{s.Builtins, "strlen", 1},
+		{s.Builtins, "count", 0},
+		{s.Statements, "Block", 1},
+		{s.Statements, "ReturnVoid", 1},
+	}
+	for _, c := range counts {
+		have, ok := c.h[c.name]
+		if !ok || have != c.want {
+			t.Errorf("%s: have %d (listed=%v), want %d", c.name, have, ok, c.want)
+		}
+	}
+
+	// Assign -> Add -> Call -> StringLit.
+	if s.ExprDepth.Count != 1 || s.ExprDepth.Max != 4 {
+		t.Errorf("expr depth: have count=%d max=%d, want count=1 max=4", s.ExprDepth.Count, s.ExprDepth.Max)
+	}
+	if s.FileLines.Max != 3 || s.ProgramSize.Max != 23 {
+		t.Errorf("sizes: have lines=%d bytes=%d", s.FileLines.Max, s.ProgramSize.Max)
+	}
+}
+
+func TestDistribution(t *testing.T) {
+	var d Distribution
+	for _, v := range []int{0, 1, 2, 3, 5} {
+		d.Add(v)
+	}
+	want := []Bucket{{0, 0, 1}, {1, 1, 1}, {2, 3, 2}, {4, 7, 1}}
+	if len(d.Buckets) != len(want) {
+		t.Fatalf("buckets: have %v, want %v", d.Buckets, want)
+	}
+	for i, b := range want {
+		if d.Buckets[i] != b {
+			t.Errorf("bucket %d: have %v, want %v", i, d.Buckets[i], b)
+		}
+	}
+	if d.Min != 0 || d.Max != 5 || d.Mean != 2.2 {
+		t.Errorf("have min=%d max=%d mean=%v", d.Min, d.Max, d.Mean)
+	}
+}
+
+func TestFeatures(t *testing.T) {
+	intType := &ir.ScalarType{Kind: ir.ScalarInt}
+	x := ir.NewVar("x", &ir.ArrayType{Elem: intType})
+	cast := &ir.Node{Op: ir.OpCast, Args: []*ir.Node{ir.NewParens(ir.NewCall(ir.NewName("count"), x))}, Type: intType}
+	f := &ir.RootFuncDecl{
+		Type: &ir.FuncType{Name: "f", Result: ir.VoidType},
+		Body: ir.NewBlock(ir.NewReturn(cast)),
+	}
+
+	features := Features([]*ir.File{{Name: "main.php", Nodes: []ir.RootNode{f}}})
+	for _, key := range []string{"op:Cast", "op:Parens", "pair:Cast>Call", "pair:Call>Var", "type:Var:array<int>", "builtin:count"} {
+		if features[key] != 1 {
+			t.Errorf("%s: have %d, want 1", key, features[key])
+		}
+	}
+	if features["pair:Cast>Parens"] != 0 {
+		t.Errorf("parens are not skipped in the pairs")
+	}
+
+	ops := FeatureOps("pair:Cast>Call")
+	if len(ops) != 2 || ops[0] != "Cast" || ops[1] != "Call" {
+		t.Errorf("pair ops: have %v", ops)
+	}
+	if ops := FeatureOps("builtin:count"); len(ops) != 1 || ops[0] != "Call" {
+		t.Errorf("builtin ops: have %v", ops)
+	}
+}
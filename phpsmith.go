@@ -0,0 +1,265 @@
+// Package phpsmith generates random PHP programs and runs them
+// with several PHP implementations to compare the results.
+//
+// It's the same engine the phpsmith command uses,
+// suitable for embedding into the other test pipelines.
+package phpsmith
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+
+	"github.com/quasilyte/phpsmith/ir"
+	"github.com/quasilyte/phpsmith/irgen"
+	"github.com/quasilyte/phpsmith/irprint"
+	"github.com/quasilyte/phpsmith/randutil"
+)
+
+// Profile describes what kind of programs to generate.
+// A zero value profile is valid.
+type Profile struct {
+	// PHP8 permits the syntax that is only available since PHP 8.
+	PHP8 bool
+
+	// PHPOnly permits the constructs that KPHP doesn't support, see irgen.Config.
+	PHPOnly bool
+
+	// Validate makes Generate check the IR with ir.Validate
+	// and fail with an *InvalidProgramError if it's inconsistent.
+	Validate bool
+
+	// SourceMap makes Generate collect the GeneratedProgram.SourceMap.
+	SourceMap bool
+
+	// RecordChoices makes Generate collect the GeneratedProgram.Choices.
+	RecordChoices bool
+
+	// ReplayChoices are the recorded choices to generate the IR from.
+	// The seed is only used for the printer then.
+	ReplayChoices []uint64
+
+	// SingleFile makes Generate print the entire program into main.php,
+	// with the runtime support functions inlined.
+	SingleFile bool
+
+	// ExprWeights scale the expression choice frequencies, see irgen.Config.
+	// FeedbackScheduler.Weights can be used to get them.
+	ExprWeights map[string]float64
+
+	// Swarm makes Generate disable a random subset of the expression choices,
+	// statement kinds and builtins, chosen by the seed.
+	// The subset is recorded in the program metadata.
+	Swarm bool
+
+	// DisabledStmts and DisabledBuiltins are passed to irgen.Config as is,
+	// they're combined with the swarm mode ones.
+	DisabledStmts    []string
+	DisabledBuiltins []string
+}
+
+// GeneratedProgram is a generated PHP program kept in memory.
+type GeneratedProgram struct {
+	Seed int64
+
+	// Files include both the generated files and the runtime support files.
+	// The program entry point is main.php.
+	Files []File
+
+	// IR is the generated code the Files are printed from.
+	// It doesn't include the runtime support files.
+	IR []*ir.File
+
+	Metadata irgen.Metadata
+
+	// ExprChoices counts the ops produced by every expression choice.
+	ExprChoices map[string]map[ir.Op]int
+
+	// SourceMap is nil unless Profile.SourceMap is set.
+	SourceMap *irprint.SourceMap
+
+	// Choices are nil unless Profile.RecordChoices is set.
+	Choices []uint64
+}
+
+// File is a PHP file of a generated program.
+type File struct {
+	Name string
+
+	Contents []byte
+}
+
+// InvalidProgramError is returned by Generate for a program that failed the IR validation.
+type InvalidProgramError struct {
+	Seed int64
+
+	Errors []*ir.ValidationError
+}
+
+func (e *InvalidProgramError) Error() string {
+	return fmt.Sprintf("seed %d: found %d IR validation errors, first: %v", e.Seed, len(e.Errors), e.Errors[0])
+}
+
+// Generate creates a program from the seed.
+// The same seed and profile always produce the same program.
+func Generate(seed int64, profile Profile) (*GeneratedProgram, error) {
+	config := &irgen.Config{
+		PHP8:             profile.PHP8,
+		PHPOnly:          profile.PHPOnly,
+		ExprWeights:      profile.ExprWeights,
+		DisabledStmts:    profile.DisabledStmts,
+		DisabledBuiltins: profile.DisabledBuiltins,
+		SingleFile:       profile.SingleFile,
+	}
+	if profile.Swarm {
+		applySwarm(config, seed)
+	}
+	var recorder *randutil.ChoiceRecorder
+	switch {
+	case profile.ReplayChoices != nil:
+		replayer := randutil.NewChoiceReplayer(profile.ReplayChoices)
+		config.Rand = replayer.Rand()
+		config.SubRand = replayer.SubRand
+	case profile.RecordChoices:
+		recorder = &randutil.ChoiceRecorder{}
+		config.Rand = recorder.Rand(rand.NewSource(seed))
+		config.SubRand = recorder.SubRand
+	default:
+		config.Rand = rand.New(rand.NewSource(seed))
+	}
+	program := irgen.CreateProgram(config)
+	if profile.Validate {
+		if errors := ir.Validate(program.Files); len(errors) != 0 {
+			return nil, &InvalidProgramError{Seed: seed, Errors: errors}
+		}
+	}
+
+	// The printer has its own random sub-stream, so the generator
+	// changes don't affect the formatting choices.
+	printerRandom := randutil.SubRand(seed, "irprint")
+	printerConfig := &irprint.Config{
+		Rand:              printerRandom,
+		AlternativeSyntax: randutil.Chance(printerRandom, 0.3),
+		ExoticLiterals:    randutil.Chance(printerRandom, 0.3),
+	}
+	if profile.SourceMap {
+		printerConfig.SourceMap = &irprint.SourceMap{}
+	}
+
+	result := &GeneratedProgram{
+		Seed:        seed,
+		IR:          program.Files,
+		Metadata:    program.Metadata,
+		ExprChoices: program.ExprChoices,
+		SourceMap:   printerConfig.SourceMap,
+	}
+	if recorder != nil {
+		result.Choices = recorder.Choices
+	}
+	if profile.SingleFile {
+		contents, err := printSingleFile(program, printerConfig)
+		if err != nil {
+			return nil, fmt.Errorf("seed %d: %w", seed, err)
+		}
+		result.Files = []File{{Name: "main.php", Contents: contents}}
+		return result, nil
+	}
+	for _, f := range program.RuntimeFiles {
+		result.Files = append(result.Files, File{Name: f.Name, Contents: f.Contents})
+	}
+	for _, f := range program.Files {
+		var buf bytes.Buffer
+		irprint.FprintFile(&buf, f.Name, f.Nodes, printerConfig)
+		result.Files = append(result.Files, File{Name: f.Name, Contents: buf.Bytes()})
+	}
+	return result, nil
+}
+
+// printSingleFile prints all program files as one.
+//
+// The files are already ordered so the interfaces and the parent classes
+// are declared before their subclasses, and main.php goes last.
+// The requires are dropped.
+//
+// The strict types mode is decided by the calling file, so the files have to agree on it,
+// see irgen.Config.SingleFile. Otherwise the program would behave differently
+// and an error is returned.
+// The runtime files only declare functions, so they're appended to the end.
+func printSingleFile(program *irgen.Program, config *irprint.Config) ([]byte, error) {
+	var nodes []ir.RootNode
+	var declare ir.RootNode
+	strictFiles := 0
+	for _, f := range program.Files {
+		for _, n := range f.Nodes {
+			switch n.(type) {
+			case *ir.RootRequire:
+				continue
+			case *ir.RootDeclare:
+				declare = n
+				strictFiles++
+				continue
+			}
+			nodes = append(nodes, n)
+		}
+	}
+	if strictFiles != 0 && strictFiles != len(program.Files) {
+		return nil, fmt.Errorf("%d of %d files declare strict_types, they can't be printed as one", strictFiles, len(program.Files))
+	}
+	if declare != nil {
+		nodes = append([]ir.RootNode{declare}, nodes...)
+	}
+
+	var buf bytes.Buffer
+	irprint.FprintFile(&buf, "main.php", nodes, config)
+	for _, f := range program.RuntimeFiles {
+		buf.WriteString("\n// " + f.Name + "\n")
+		buf.Write(bytes.TrimPrefix(f.Contents, []byte("<?php\n")))
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteDir writes the program files into dir along with the metadata.json,
+// and the sourcemap.json and choices.bin if they were collected.
+func (p *GeneratedProgram) WriteDir(dir string) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil && !os.IsExist(err) {
+		return err
+	}
+
+	writeFile := func(name string, data []byte) error {
+		fullname := filepath.Join(dir, name)
+		if err := os.WriteFile(fullname, data, 0o664); err != nil {
+			return fmt.Errorf("create %s file: %w", fullname, err)
+		}
+		return nil
+	}
+	writeJSON := func(name string, v interface{}) error {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encode %s: %w", name, err)
+		}
+		return writeFile(name, append(data, '\n'))
+	}
+
+	for _, f := range p.Files {
+		if err := writeFile(f.Name, f.Contents); err != nil {
+			return err
+		}
+	}
+	if err := writeJSON("metadata.json", p.Metadata); err != nil {
+		return err
+	}
+	if p.SourceMap != nil {
+		if err := writeJSON("sourcemap.json", p.SourceMap); err != nil {
+			return err
+		}
+	}
+	if p.Choices != nil {
+		if err := writeFile("choices.bin", randutil.EncodeChoices(p.Choices)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
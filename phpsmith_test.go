@@ -0,0 +1,225 @@
+package phpsmith
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/quasilyte/phpsmith/ir"
+	"github.com/quasilyte/phpsmith/irgen"
+	"github.com/quasilyte/phpsmith/irprint"
+)
+
+func TestGenerate(t *testing.T) {
+	profile := Profile{Validate: true, RecordChoices: true}
+	p1, err := Generate(10, profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2, err := Generate(10, Profile{ReplayChoices: p1.Choices})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p1.Files) != len(p2.Files) {
+		t.Fatalf("generated %d and %d files", len(p1.Files), len(p2.Files))
+	}
+	for i, f := range p1.Files {
+		if f.Name != p2.Files[i].Name || !bytes.Equal(f.Contents, p2.Files[i].Contents) {
+			t.Fatalf("%s differs after the choices replay", f.Name)
+		}
+	}
+
+	dir := t.TempDir()
+	if err := p1.WriteDir(dir); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"main.php", "fuzzlib.php", "metadata.json", "choices.bin"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("missing %s: %v", name, err)
+		}
+	}
+}
+
+func TestGenerateSingleFile(t *testing.T) {
+	for seed := int64(0); seed < 10; seed++ {
+		p, err := Generate(seed, Profile{SingleFile: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(p.Files) != 1 || p.Files[0].Name != "main.php" {
+			t.Fatalf("seed %d: expected only main.php, got %d files", seed, len(p.Files))
+		}
+		contents := string(p.Files[0].Contents)
+		if strings.Contains(contents, "require_once") {
+			t.Errorf("seed %d: single file output contains requires", seed)
+		}
+		if n := strings.Count(contents, "<?php"); n != 1 {
+			t.Errorf("seed %d: found %d <?php tags", seed, n)
+		}
+		if n := strings.Count(contents, "declare(strict_types=1)"); n > 1 {
+			t.Errorf("seed %d: found %d strict_types declarations", seed, n)
+		} else if strictFiles := len(p.Metadata.StrictTypesFiles); (n == 1) != (strictFiles != 0) {
+			t.Errorf("seed %d: found %d strict_types declarations, but %d files are strict", seed, n, strictFiles)
+		}
+		if !strings.Contains(contents, "function dump_with_pos(") {
+			t.Errorf("seed %d: runtime functions are not inlined", seed)
+		}
+	}
+}
+
+func TestPrintSingleFileStrictTypes(t *testing.T) {
+	f := &ir.FuncType{Name: "f", Params: []ir.TypeField{{Name: "x", Type: ir.FloatType}}, MinArgsNum: 1, Result: ir.VoidType}
+	strictTypes := &ir.RootDeclare{Directive: "strict_types", Value: ir.NewIntLit(1)}
+	libFunc := &ir.RootFuncDecl{Type: f, Body: ir.NewBlock(ir.NewEcho(ir.NewVar("x", ir.FloatType)))}
+	mainCall := &ir.RootStmt{X: ir.NewCall(ir.NewName("f"), ir.NewIntLit(1))}
+
+	tests := []struct {
+		libStrict  bool
+		mainStrict bool
+		want       string
+	}{
+		{false, false, "<?php\nfunction f($x) {\n  echo $x;\n}\n\nf(1);\n"},
+		{true, false, "error: 1 of 2 files declare strict_types, they can't be printed as one"},
+		{false, true, "error: 1 of 2 files declare strict_types, they can't be printed as one"},
+		{true, true, "<?php\ndeclare(strict_types=1);\nfunction f($x) {\n  echo $x;\n}\n\nf(1);\n"},
+	}
+
+	for _, test := range tests {
+		lib := &ir.File{Name: "lib.php", Nodes: []ir.RootNode{libFunc}}
+		mainFile := &ir.File{Name: "main.php", Nodes: []ir.RootNode{&ir.RootRequire{Path: "lib.php"}, mainCall}}
+		if test.libStrict {
+			lib.Nodes = append([]ir.RootNode{strictTypes}, lib.Nodes...)
+		}
+		if test.mainStrict {
+			mainFile.Nodes = append([]ir.RootNode{strictTypes}, mainFile.Nodes...)
+		}
+		program := &irgen.Program{Files: []*ir.File{lib, mainFile}}
+		contents, err := printSingleFile(program, &irprint.Config{})
+		have := string(contents)
+		if err != nil {
+			have = "error: " + err.Error()
+		}
+		if have != test.want {
+			t.Errorf("lib strict=%v, main strict=%v:\nhave: %q\nwant: %q", test.libStrict, test.mainStrict, have, test.want)
+		}
+	}
+}
+
+type fakeRunner struct {
+	name   string
+	output string
+	err    error
+}
+
+func (r fakeRunner) Run(ctx context.Context, dir string, seed int64) ([]byte, error) {
+	return []byte(r.output), r.err
+}
+
+func (r fakeRunner) Name() string { return r.name }
+
+func TestDifferential(t *testing.T) {
+	tests := []struct {
+		runners []Runner
+		found   bool
+		diff    string
+	}{
+		{[]Runner{fakeRunner{"a", "1\n", nil}, fakeRunner{"b", "1\n", nil}}, false, ""},
+		{[]Runner{fakeRunner{"a", "1\n", nil}, fakeRunner{"b", "2\n", nil}}, true, "a vs b:"},
+		{[]Runner{fakeRunner{"a", "", nil}, fakeRunner{"b", "", errors.New("crash")}}, true, ""},
+	}
+
+	for i, test := range tests {
+		d := &Differential{Runners: test.runners}
+		result := d.Run(context.Background(), "dir", 1)
+		if result.Found() != test.found {
+			t.Errorf("test%d: found=%v, want %v", i, result.Found(), test.found)
+		}
+		if !strings.HasPrefix(result.Diff, test.diff) || (test.diff == "") != (result.Diff == "") {
+			t.Errorf("test%d: unexpected diff %q", i, result.Diff)
+		}
+	}
+}
+
+func TestFeedbackScheduler(t *testing.T) {
+	names := make(map[string]bool)
+	for _, name := range irgen.ExprChoiceNames() {
+		names[name] = true
+	}
+
+	s := NewFeedbackScheduler()
+	if s.Weights() != nil {
+		t.Fatal("weights are set before any program is observed")
+	}
+	for seed := int64(0); seed < 5; seed++ {
+		p, err := Generate(seed, Profile{ExprWeights: s.Weights()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.Observe(p, seed == 4)
+	}
+
+	weights := s.Weights()
+	if len(weights) == 0 {
+		t.Fatal("no weights after observing the programs")
+	}
+	for name, w := range weights {
+		if !names[name] {
+			t.Errorf("unexpected choice name %q", name)
+		}
+		if w < 1 || w > feedbackMaxBoost {
+			t.Errorf("%s: weight %v is out of range", name, w)
+		}
+	}
+
+	p, err := Generate(10, Profile{ExprWeights: weights, Validate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Metadata.ExprWeights) != len(weights) {
+		t.Errorf("metadata has %d weights, want %d", len(p.Metadata.ExprWeights), len(weights))
+	}
+}
+
+func TestGenerateSwarm(t *testing.T) {
+	p1, err := Generate(3, Profile{Swarm: true, Validate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := p1.Metadata
+	if len(m.DisabledStmts) == 0 || len(m.DisabledBuiltins) == 0 || len(m.ExprWeights) == 0 {
+		t.Fatalf("swarm configuration is not recorded: %+v", m)
+	}
+	for _, f := range p1.IR {
+		for _, n := range f.Nodes {
+			ir.Walk(n, func(n *ir.Node, c *ir.Cursor) bool {
+				if n.Op == ir.OpCall && n.Args[0].Op == ir.OpName {
+					for _, name := range m.DisabledBuiltins {
+						if n.Args[0].Value == name {
+							t.Fatalf("disabled %s builtin is called", name)
+						}
+					}
+				}
+				return true
+			})
+		}
+	}
+
+	// The recorded configuration reproduces the program.
+	p2, err := Generate(3, Profile{
+		ExprWeights:      m.ExprWeights,
+		DisabledStmts:    m.DisabledStmts,
+		DisabledBuiltins: m.DisabledBuiltins,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, f := range p1.Files {
+		if !bytes.Equal(f.Contents, p2.Files[i].Contents) {
+			t.Fatalf("%s differs after the swarm configuration replay", f.Name)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package randutil
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+)
+
+// ChoiceRecorder logs every value produced by the random sources it creates.
+//
+// Every Intn, Float64, Chance and other decision is derived from these values,
+// so the log can be fed back with a ChoiceReplayer to repeat the same decisions.
+// The values are logged in the order they're drawn, even if they come
+// from different sub-streams.
+//
+// Note that the log holds the raw source values, not the decisions:
+// a single decision can draw several values (like Intn does for
+// the rejection sampling) and the meaning of a value depends on
+// the decisions made before it.
+type ChoiceRecorder struct {
+	Choices []uint64
+}
+
+// Rand returns a random source that draws from src and logs the drawn values.
+func (rec *ChoiceRecorder) Rand(src rand.Source) *rand.Rand {
+	return rand.New(&recordingSource{src: toSource64(src), rec: rec})
+}
+
+// SubRand is like the package SubRand, but the returned source is recorded.
+func (rec *ChoiceRecorder) SubRand(seed int64, name string) *rand.Rand {
+	return rec.Rand(rand.NewSource(SubSeed(seed, name)))
+}
+
+type recordingSource struct {
+	src rand.Source64
+	rec *ChoiceRecorder
+}
+
+func (s *recordingSource) Seed(seed int64) { s.src.Seed(seed) }
+
+func (s *recordingSource) Int63() int64 {
+	v := s.src.Int63()
+	s.rec.Choices = append(s.rec.Choices, uint64(v))
+	return v
+}
+
+func (s *recordingSource) Uint64() uint64 {
+	v := s.src.Uint64()
+	s.rec.Choices = append(s.rec.Choices, v)
+	return v
+}
+
+// ChoiceReplayer is a random source that produces the given choices in order.
+//
+// All sub-streams share a single replayed sequence, so the generation
+// becomes a pure function of the choices. When the choices are exhausted,
+// the values come from a fixed-seed source: a shortened sequence
+// still produces a complete program and the retry loops always terminate.
+type ChoiceReplayer struct {
+	choices []uint64
+	pos     int
+
+	tail rand.Source64
+	rand *rand.Rand
+}
+
+func NewChoiceReplayer(choices []uint64) *ChoiceReplayer {
+	rep := &ChoiceReplayer{
+		choices: choices,
+		tail:    toSource64(rand.NewSource(0)),
+	}
+	rep.rand = rand.New(rep)
+	return rep
+}
+
+// Rand returns a random source that replays the choices.
+func (rep *ChoiceReplayer) Rand() *rand.Rand { return rep.rand }
+
+// SubRand returns the same source as Rand, the sub-stream names are ignored.
+func (rep *ChoiceReplayer) SubRand(seed int64, name string) *rand.Rand { return rep.rand }
+
+// Consumed reports how many choices were replayed so far.
+func (rep *ChoiceReplayer) Consumed() int { return rep.pos }
+
+func (rep *ChoiceReplayer) Seed(seed int64) {}
+
+func (rep *ChoiceReplayer) Int63() int64 {
+	return int64(rep.Uint64() & (1<<63 - 1))
+}
+
+func (rep *ChoiceReplayer) Uint64() uint64 {
+	if rep.pos >= len(rep.choices) {
+		return rep.tail.Uint64()
+	}
+	v := rep.choices[rep.pos]
+	rep.pos++
+	return v
+}
+
+func toSource64(src rand.Source) rand.Source64 {
+	if src64, ok := src.(rand.Source64); ok {
+		return src64
+	}
+	return source64{src}
+}
+
+type source64 struct {
+	rand.Source
+}
+
+func (s source64) Uint64() uint64 {
+	return uint64(s.Int63())>>31 | uint64(s.Int63())<<32
+}
+
+// EncodeChoices encodes the choices as a byte sequence.
+func EncodeChoices(choices []uint64) []byte {
+	data := make([]byte, 0, len(choices)*binary.MaxVarintLen64)
+	var buf [binary.MaxVarintLen64]byte
+	for _, v := range choices {
+		n := binary.PutUvarint(buf[:], v)
+		data = append(data, buf[:n]...)
+	}
+	return data
+}
+
+// DecodeChoices decodes the choices encoded by EncodeChoices.
+func DecodeChoices(data []byte) ([]uint64, error) {
+	var choices []uint64
+	offset := 0
+	for offset < len(data) {
+		v, n := binary.Uvarint(data[offset:])
+		if n <= 0 {
+			return nil, fmt.Errorf("bad choice encoding at byte %d", offset)
+		}
+		choices = append(choices, v)
+		offset += n
+	}
+	return choices, nil
+}
+
+// ShrinkChoices minimizes the choices while interesting keeps returning true for them.
+//
+// It tries to drop the chunks of choices, then to replace the individual
+// choices with zeros or halve them, until none of that helps anymore.
+// The given choices should be interesting.
+//
+// The shrinking is heuristic: the choices are the raw values recorded
+// by ChoiceRecorder, so dropping a value shifts all the decisions after it
+// and a smaller sequence doesn't always mean a smaller program.
+// Any sequence is still replayed to a complete program, see ChoiceReplayer.
+func ShrinkChoices(choices []uint64, interesting func([]uint64) bool) []uint64 {
+	current := append([]uint64(nil), choices...)
+	for changed := true; changed; {
+		changed = false
+
+		for size := len(current) / 2; size > 0; size /= 2 {
+			for i := 0; i+size <= len(current); {
+				candidate := make([]uint64, 0, len(current)-size)
+				candidate = append(candidate, current[:i]...)
+				candidate = append(candidate, current[i+size:]...)
+				if interesting(candidate) {
+					current = candidate
+					changed = true
+					continue
+				}
+				i += size
+			}
+		}
+
+		for i := range current {
+			if current[i] == 0 {
+				continue
+			}
+			for _, v := range []uint64{0, current[i] / 2} {
+				candidate := append([]uint64(nil), current...)
+				candidate[i] = v
+				if interesting(candidate) {
+					current = candidate
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return current
+}
@@ -0,0 +1,65 @@
+package randutil
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestChoicesEncoding(t *testing.T) {
+	rec := &ChoiceRecorder{}
+	r := rec.Rand(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		r.Intn(1000)
+		Chance(r, 0.5)
+	}
+	choices, err := DecodeChoices(EncodeChoices(rec.Choices))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(choices) != fmt.Sprint(rec.Choices) {
+		t.Fatal("decoded choices differ from the encoded ones")
+	}
+
+	want := rand.New(rand.NewSource(1))
+	replayed := NewChoiceReplayer(choices).Rand()
+	for i := 0; i < 100; i++ {
+		if have, want := replayed.Intn(1000), want.Intn(1000); have != want {
+			t.Fatalf("replayed Intn: have %d, want %d", have, want)
+		}
+		if have, want := Chance(replayed, 0.5), Chance(want, 0.5); have != want {
+			t.Fatalf("replayed Chance: have %v, want %v", have, want)
+		}
+	}
+}
+
+func TestShrinkChoices(t *testing.T) {
+	// A choices sequence is interesting if it makes
+	// at least two of the drawn numbers greater than 50.
+	interesting := func(choices []uint64) bool {
+		r := NewChoiceReplayer(choices).Rand()
+		n := 0
+		for i := 0; i < len(choices); i++ {
+			if r.Intn(100) > 50 {
+				n++
+			}
+		}
+		return n >= 2
+	}
+
+	rec := &ChoiceRecorder{}
+	r := rec.Rand(rand.NewSource(2))
+	for i := 0; i < 200; i++ {
+		r.Intn(100)
+	}
+	if !interesting(rec.Choices) {
+		t.Fatal("the initial choices are not interesting")
+	}
+	shrunk := ShrinkChoices(rec.Choices, interesting)
+	if len(shrunk) != 2 {
+		t.Fatalf("shrunk to %d choices, want 2", len(shrunk))
+	}
+	if !interesting(shrunk) {
+		t.Fatal("the shrunk choices are not interesting")
+	}
+}
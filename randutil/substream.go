@@ -0,0 +1,25 @@
+package randutil
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math/rand"
+)
+
+// SubSeed derives a seed of the named sub-stream from the root seed.
+//
+// The sub-streams with different names are independent:
+// the number of values drawn from one of them doesn't affect the others.
+func SubSeed(seed int64, name string) int64 {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(seed))
+	h := fnv.New64a()
+	h.Write(buf[:])
+	h.Write([]byte(name))
+	return int64(h.Sum64())
+}
+
+// SubRand returns a random source for the named sub-stream of the root seed.
+func SubRand(seed int64, name string) *rand.Rand {
+	return rand.New(rand.NewSource(SubSeed(seed, name)))
+}
@@ -0,0 +1,44 @@
+package phpsmith
+
+import (
+	"github.com/quasilyte/phpsmith/irgen"
+	"github.com/quasilyte/phpsmith/phpfunc"
+	"github.com/quasilyte/phpsmith/randutil"
+)
+
+// swarmDisableChance is a chance of every feature to be disabled in the swarm mode.
+const swarmDisableChance = 0.5
+
+// applySwarm disables a random subset of the expression choices,
+// statement kinds and builtins. The subset is chosen by the seed.
+//
+// The rare features are crowded out by the frequent ones when everything
+// is enabled; with some features disabled, the rest interact more.
+func applySwarm(config *irgen.Config, seed int64) {
+	r := randutil.SubRand(seed, "swarm")
+
+	weights := make(map[string]float64, len(config.ExprWeights))
+	for name, w := range config.ExprWeights {
+		weights[name] = w
+	}
+	for _, name := range irgen.ExprChoiceNames() {
+		if randutil.Chance(r, swarmDisableChance) {
+			weights[name] = 0
+		}
+	}
+	config.ExprWeights = weights
+
+	// Don't append to the caller-owned slices.
+	config.DisabledStmts = append([]string(nil), config.DisabledStmts...)
+	config.DisabledBuiltins = append([]string(nil), config.DisabledBuiltins...)
+	for _, kind := range irgen.StmtKinds() {
+		if randutil.Chance(r, swarmDisableChance) {
+			config.DisabledStmts = append(config.DisabledStmts, kind)
+		}
+	}
+	for _, fn := range phpfunc.GetList() {
+		if randutil.Chance(r, swarmDisableChance) {
+			config.DisabledBuiltins = append(config.DisabledBuiltins, fn.Name)
+		}
+	}
+}